@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+// CreateApplication creates an application, and returns it as stored by the server.
+func (c *Client) CreateApplication(ctx context.Context, application *model.AppDescriptor) (*model.AppDescriptor, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.applications.CreateApplication(serviceCtx, application)
+}
+
+// GetApplication returns the application with the given key.
+func (c *Client) GetApplication(ctx context.Context, applicationKey string) (*model.AppDescriptor, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.applications.GetApplication(serviceCtx, applicationKey)
+}
+
+// UpdateApplication updates the fields that are set in application, and returns the updated application.
+// The application is identified by its ApplicationKey.
+func (c *Client) UpdateApplication(ctx context.Context, application *model.AppDescriptor) (*model.AppDescriptor, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.applications.UpdateApplication(serviceCtx, application)
+}
+
+// DeleteApplication deletes the application with the given key.
+func (c *Client) DeleteApplication(ctx context.Context, applicationKey string) error {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return err
+	}
+	return c.applications.DeleteApplication(serviceCtx, applicationKey)
+}
+
+// ListApplications returns an iterator over the applications that match the request. A nil request lists all the
+// applications. Pages are requested from the server as the applications are consumed, up to the MaxItems of the request,
+// if it is set. Iteration stops after an error.
+func (c *Client) ListApplications(ctx context.Context, request *model.ListApplicationsRequest) iter.Seq2[model.AppDescriptor, error] {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return failedSeq[model.AppDescriptor](err)
+	}
+	return c.applications.ListApplications(serviceCtx, request)
+}
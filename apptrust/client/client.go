@@ -0,0 +1,164 @@
+// Package client is a Go client for the AppTrust API.
+//
+// Create a client with the URL of the JFrog Platform and an access token, and call its methods:
+//
+//	apptrust, err := client.New(client.WithUrl("https://acme.jfrog.io"), client.WithAccessToken(token))
+//	if err != nil {
+//		return err
+//	}
+//	app, err := apptrust.GetApplication(ctx, "my-app")
+//
+// Methods return the results as typed values of the model package, and don't write to stdout or stderr.
+// Requests are canceled when their context is done. Failed requests that are safe to repeat are retried,
+// see WithRetries. Errors returned by the server are of type *Error, which holds the status code of the response.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"iter"
+	"net/http"
+	"time"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/systems"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+)
+
+// Error is an error response of the AppTrust API. Use errors.As to inspect it, e.g., to check the status code.
+type Error = apphttp.ApptrustError
+
+// Logger receives the diagnostic messages of the client: the requests it sends, the retries of failed requests,
+// and the status checks of WaitForVersion.
+type Logger = apphttp.Logger
+
+// Client calls the AppTrust API. It is safe for concurrent use.
+type Client struct {
+	serverDetails coreConfig.ServerDetails
+	httpClient    *http.Client
+	tlsConfig     *tls.Config
+	retries       int
+	retryWait     time.Duration
+	logger        Logger
+
+	applications applications.ApplicationService
+	versions     versions.VersionService
+	packages     packages.PackageService
+	systems      systems.SystemService
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithUrl sets the URL of the JFrog Platform, e.g., https://acme.jfrog.io. It is required.
+func WithUrl(url string) Option {
+	return func(c *Client) {
+		c.serverDetails.Url = clientUtils.AddTrailingSlashIfNeeded(url)
+	}
+}
+
+// WithAccessToken sets the access token that authenticates the requests.
+func WithAccessToken(token string) Option {
+	return func(c *Client) {
+		c.serverDetails.AccessToken = token
+	}
+}
+
+// WithTlsConfig sets the TLS configuration of the connections, e.g., to trust a private certificate authority
+// or to present a client certificate. It is ignored if WithHttpClient is set.
+func WithTlsConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// WithHttpClient sends the requests with httpClient, instead of a client created by New.
+func WithHttpClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetries sets the number of times a failed request is retried, and the wait before the first retry.
+// The wait doubles after each retry. By default, requests are retried 3 times, starting after 1 second.
+// Zero retries disables retries.
+func WithRetries(retries int, wait time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.retryWait = wait
+	}
+}
+
+// WithLogger sets the logger of the client's diagnostic messages. By default, they are discarded.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// New creates a Client. WithUrl is required.
+func New(options ...Option) (*Client, error) {
+	c := &Client{
+		retries:      apphttp.DefaultRetries,
+		retryWait:    apphttp.DefaultRetryWait,
+		logger:       discardLogger{},
+		applications: applications.NewApplicationService(),
+		versions:     versions.NewVersionService(),
+		packages:     packages.NewPackageService(),
+		systems:      systems.NewSystemService(),
+	}
+	for _, option := range options {
+		option(c)
+	}
+	if c.serverDetails.Url == "" {
+		return nil, errors.New("the URL of the JFrog Platform is required, see WithUrl")
+	}
+	if c.httpClient == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if c.tlsConfig != nil {
+			transport.TLSClientConfig = c.tlsConfig
+		}
+		c.httpClient = &http.Client{Transport: transport}
+	}
+	return c, nil
+}
+
+// newContext returns the context of a service call, whose requests are canceled when ctx is done.
+func (c *Client) newContext(ctx context.Context) (service.Context, error) {
+	return service.NewContext(c.serverDetails,
+		apphttp.WithContext(ctx),
+		apphttp.WithHttpClient(c.httpClient),
+		apphttp.WithRetries(c.retries),
+		apphttp.WithRetryWait(c.retryWait),
+		apphttp.WithLogger(c.logger))
+}
+
+// Ping checks that the AppTrust service is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = c.systems.Ping(serviceCtx)
+	return err
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Debug(...interface{}) {}
+
+func (discardLogger) Warn(...interface{}) {}
+
+// failedSeq returns an iterator that yields err, for methods that return an iterator.
+func failedSeq[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/fakeserver"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPackage = fakeserver.Package{
+	Type:          "npm",
+	Name:          "web",
+	Version:       "1.0.0",
+	RepositoryKey: "npm-local",
+	Artifacts:     []model.ReleasableArtifact{{Path: "web/-/web-1.0.0.tgz", SHA256: "abc123"}},
+}
+
+func newTestClient(t *testing.T, options ...Option) *Client {
+	server, serverDetails := fakeserver.StartTestServer(t)
+	require.NoError(t, server.Seed(fakeserver.SeedData{Packages: []fakeserver.Package{testPackage}}))
+	options = append([]Option{WithUrl(serverDetails.Url), WithAccessToken(serverDetails.AccessToken), WithRetries(0, 0)}, options...)
+	client, err := New(options...)
+	require.NoError(t, err)
+	return client
+}
+
+func packageSources() *model.CreateVersionSources {
+	return &model.CreateVersionSources{Packages: []model.CreateVersionPackage{
+		{Type: testPackage.Type, Name: testPackage.Name, Version: testPackage.Version, Repository: testPackage.RepositoryKey},
+	}}
+}
+
+func TestNew_RequiresUrl(t *testing.T) {
+	_, err := New(WithAccessToken("token"))
+	assert.EqualError(t, err, "the URL of the JFrog Platform is required, see WithUrl")
+}
+
+func TestClient_Applications(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	require.NoError(t, client.Ping(ctx))
+
+	created, err := client.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", ApplicationName: "Web", ProjectKey: "proj"})
+	require.NoError(t, err)
+	assert.Equal(t, "web", created.ApplicationKey)
+
+	description := "The web application"
+	updated, err := client.UpdateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", Description: &description})
+	require.NoError(t, err)
+	assert.Equal(t, &description, updated.Description)
+
+	var keys []string
+	for app, err := range client.ListApplications(ctx, nil) {
+		require.NoError(t, err)
+		keys = append(keys, app.ApplicationKey)
+	}
+	assert.Equal(t, []string{"web"}, keys)
+
+	require.NoError(t, client.DeleteApplication(ctx, "web"))
+	_, err = client.GetApplication(ctx, "web")
+	var apptrustErr *Error
+	require.True(t, errors.As(err, &apptrustErr))
+	assert.Equal(t, http.StatusNotFound, apptrustErr.StatusCode)
+}
+
+func TestClient_Versions(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	_, err := client.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", ApplicationName: "Web", ProjectKey: "proj"})
+	require.NoError(t, err)
+
+	created, err := client.CreateVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "web", Version: "1.0.0", Sources: packageSources()},
+		CreateVersionOptions{Async: true})
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusStarted, created.Status)
+
+	content, err := client.WaitForVersion(ctx, "web", "1.0.0", model.WaitAppVersionRequest{Interval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusCompleted, content.Status)
+
+	_, err = client.PromoteVersion(ctx, "web", "1.0.0", &model.PromoteAppVersionRequest{Stage: "QA"}, StageOptions{})
+	require.NoError(t, err)
+	_, err = client.ReleaseVersion(ctx, "web", "1.0.0", &model.ReleaseAppVersionRequest{}, StageOptions{})
+	require.NoError(t, err)
+	rollback, err := client.RollbackVersion(ctx, "web", "1.0.0", fakeserver.ReleaseStage, StageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "QA", rollback.RollbackToStage)
+
+	events, err := client.GetVersionHistory(ctx, "web", "1.0.0")
+	require.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	content, err = client.GetVersion(ctx, "web", "1.0.0", true)
+	require.NoError(t, err)
+	assert.Equal(t, "QA", content.CurrentStage)
+	require.Len(t, content.Releasables, 1)
+	assert.Equal(t, testPackage.Artifacts, content.Releasables[0].Artifacts)
+
+	_, err = client.CreateVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "web", Version: "1.1.0", Draft: true}, CreateVersionOptions{})
+	require.NoError(t, err)
+	diff, err := client.DiffVersions(ctx, model.VersionRef{ApplicationKey: "web", Version: "1.0.0"}, model.VersionRef{ApplicationKey: "web", Version: "1.1.0"})
+	require.NoError(t, err)
+	require.Len(t, diff.Packages, 1)
+	assert.Equal(t, "web", diff.Packages[0].Name)
+
+	var versions []string
+	for version, err := range client.ListVersions(ctx, "web", nil) {
+		require.NoError(t, err)
+		versions = append(versions, version.Version)
+	}
+	assert.Equal(t, []string{"1.1.0", "1.0.0"}, versions)
+}
+
+func TestClient_Packages(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	_, err := client.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", ApplicationName: "Web", ProjectKey: "proj"})
+	require.NoError(t, err)
+
+	_, err = client.BindPackage(ctx, "web", &model.BindPackageRequest{Type: "npm", Name: "web", Version: "1.0.0"})
+	require.NoError(t, err)
+	var bindings []model.PackageBinding
+	for binding, err := range client.ListBoundPackages(ctx, "web", nil) {
+		require.NoError(t, err)
+		bindings = append(bindings, binding)
+	}
+	assert.Equal(t, []model.PackageBinding{{Type: "npm", Name: "web", NumVersions: 1, LatestVersion: "1.0.0"}}, bindings)
+	assert.NoError(t, client.UnbindPackage(ctx, "web", "npm", "web", "1.0.0"))
+}
+
+func TestClient_DoesNotLog(t *testing.T) {
+	var output bytes.Buffer
+	previousLogger := log.GetLogger()
+	log.SetLogger(log.NewLogger(log.INFO, &output))
+	t.Cleanup(func() { log.SetLogger(previousLogger) })
+
+	client := newTestClient(t)
+	ctx := context.Background()
+	_, err := client.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", ApplicationName: "Web", ProjectKey: "proj"})
+	require.NoError(t, err)
+	_, err = client.CreateVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "web", Version: "1.0.0", Sources: packageSources()}, CreateVersionOptions{Async: true})
+	require.NoError(t, err)
+	_, err = client.WaitForVersion(ctx, "web", "1.0.0", model.WaitAppVersionRequest{Interval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteVersion(ctx, "web", "1.0.0"))
+	require.NoError(t, client.Ping(ctx))
+
+	assert.Empty(t, output.String())
+}
+
+// recordingLogger records the messages of the client.
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Debug(a ...interface{}) {
+	l.messages = append(l.messages, "debug: "+fmt.Sprint(a...))
+}
+
+func (l *recordingLogger) Warn(a ...interface{}) {
+	l.messages = append(l.messages, "warn: "+fmt.Sprint(a...))
+}
+
+func TestClient_Logger(t *testing.T) {
+	logger := &recordingLogger{}
+	client := newTestClient(t, WithLogger(logger))
+	require.NoError(t, client.Ping(context.Background()))
+	require.Len(t, logger.messages, 1)
+	assert.True(t, strings.HasPrefix(logger.messages[0], "debug: Sending GET request to:"), logger.messages[0])
+}
+
+func TestClient_LoggerWaitForVersion(t *testing.T) {
+	logger := &recordingLogger{}
+	client := newTestClient(t, WithLogger(logger))
+	ctx := context.Background()
+	_, err := client.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", ApplicationName: "Web", ProjectKey: "proj"})
+	require.NoError(t, err)
+	_, err = client.CreateVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "web", Version: "1.0.0", Sources: packageSources()},
+		CreateVersionOptions{Async: true})
+	require.NoError(t, err)
+
+	_, err = client.WaitForVersion(ctx, "web", "1.0.0", model.WaitAppVersionRequest{Interval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(logger.messages, "\n"), "debug: Application version web:1.0.0 status is STARTED. Checking again in 10ms.")
+}
+
+func TestClient_Canceled(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := client.Ping(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	_, serverDetails := fakeserver.StartTestServer(t, fakeserver.WithAccessToken("secret"))
+	client, err := New(WithUrl(serverDetails.Url), WithAccessToken("wrong"), WithRetries(0, 0))
+	require.NoError(t, err)
+
+	err = client.Ping(context.Background())
+	var apptrustErr *apphttp.ApptrustError
+	require.True(t, errors.As(err, &apptrustErr))
+	assert.Equal(t, http.StatusUnauthorized, apptrustErr.StatusCode)
+}
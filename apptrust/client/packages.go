@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+// BindPackage binds a package version to the application.
+func (c *Client) BindPackage(ctx context.Context, applicationKey string, request *model.BindPackageRequest) (*model.BindPackageResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.packages.BindPackage(serviceCtx, applicationKey, request)
+}
+
+// UnbindPackage unbinds a package version from the application.
+func (c *Client) UnbindPackage(ctx context.Context, applicationKey, packageType, packageName, packageVersion string) error {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return err
+	}
+	return c.packages.UnbindPackage(serviceCtx, applicationKey, packageType, packageName, packageVersion)
+}
+
+// ListBoundPackages returns an iterator over the packages bound to the application that match the request.
+// A nil request lists all the bound packages. The MaxItems of the request caps the number of returned packages.
+// Iteration stops after an error.
+func (c *Client) ListBoundPackages(ctx context.Context, applicationKey string, request *model.ListBoundPackagesRequest) iter.Seq2[model.PackageBinding, error] {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return failedSeq[model.PackageBinding](err)
+	}
+	return c.packages.ListBoundPackages(serviceCtx, applicationKey, request)
+}
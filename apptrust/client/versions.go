@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+const (
+	// DefaultWaitTimeout is the timeout of WaitForVersion, if the request does not set one.
+	DefaultWaitTimeout = 10 * time.Minute
+	// DefaultWaitInterval is the initial time between polls of WaitForVersion, if the request does not set one.
+	DefaultWaitInterval = 5 * time.Second
+)
+
+// CreateVersionOptions controls how CreateVersion runs. The zero value creates the version synchronously.
+type CreateVersionOptions struct {
+	// Async returns once the server accepted the request, before the version is created.
+	// Use WaitForVersion to wait for the version to be created.
+	Async bool
+	// DryRun validates the request without creating the version.
+	DryRun bool
+}
+
+// UpdateVersionSourcesOptions controls how UpdateVersionSources runs. The zero value updates the sources synchronously,
+// and stops on the first source that fails.
+type UpdateVersionSourcesOptions struct {
+	// Async returns once the server accepted the request, before the sources are updated.
+	Async bool
+	// DryRun validates the request without updating the sources.
+	DryRun bool
+	// ContinueOnError adds the sources that succeed when other sources fail.
+	ContinueOnError bool
+}
+
+// StageOptions controls how PromoteVersion, ReleaseVersion and RollbackVersion run.
+// The zero value waits for the version to move to the stage.
+type StageOptions struct {
+	// Async returns once the server accepted the request, before the version moved to the stage.
+	Async bool
+}
+
+// CreateVersion creates an application version from the sources of the request.
+func (c *Client) CreateVersion(ctx context.Context, request *model.CreateAppVersionRequest, options CreateVersionOptions) (*model.AppVersionResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.CreateAppVersion(serviceCtx, request, !options.Async, options.DryRun)
+}
+
+// GetVersion returns the application version and its releasables. The artifacts of the releasables are
+// returned only if includeArtifacts is true.
+func (c *Client) GetVersion(ctx context.Context, applicationKey, version string, includeArtifacts bool) (*model.VersionContentResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.GetAppVersion(serviceCtx, applicationKey, version, includeArtifacts)
+}
+
+// ListVersions returns an iterator over the versions of the application that match the request.
+// A nil request lists all the versions. The MaxItems of the request caps the number of returned versions.
+// Iteration stops after an error.
+func (c *Client) ListVersions(ctx context.Context, applicationKey string, request *model.ListAppVersionsRequest) iter.Seq2[model.AppVersion, error] {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return failedSeq[model.AppVersion](err)
+	}
+	return c.versions.ListAppVersions(serviceCtx, applicationKey, request)
+}
+
+// UpdateVersion updates the tag and properties of the application version.
+func (c *Client) UpdateVersion(ctx context.Context, applicationKey, version string, request *model.UpdateAppVersionRequest) error {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return err
+	}
+	return c.versions.UpdateAppVersion(serviceCtx, applicationKey, version, request)
+}
+
+// UpdateVersionSources adds sources to a draft application version, and filters its releasables.
+func (c *Client) UpdateVersionSources(ctx context.Context, applicationKey, version string, request *model.UpdateVersionSourcesRequest, options UpdateVersionSourcesOptions) (*model.AppVersionResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.UpdateAppVersionSources(serviceCtx, applicationKey, version, request, !options.Async, options.DryRun, !options.ContinueOnError)
+}
+
+// DeleteVersion deletes the application version.
+func (c *Client) DeleteVersion(ctx context.Context, applicationKey, version string) error {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return err
+	}
+	return c.versions.DeleteAppVersion(serviceCtx, applicationKey, version)
+}
+
+// PromoteVersion promotes the application version to the target stage of the request.
+func (c *Client) PromoteVersion(ctx context.Context, applicationKey, version string, request *model.PromoteAppVersionRequest, options StageOptions) (*model.PromoteAppVersionResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.PromoteAppVersion(serviceCtx, applicationKey, version, request, !options.Async)
+}
+
+// ReleaseVersion releases the application version to the production stage.
+func (c *Client) ReleaseVersion(ctx context.Context, applicationKey, version string, request *model.ReleaseAppVersionRequest, options StageOptions) (*model.PromoteAppVersionResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.ReleaseAppVersion(serviceCtx, applicationKey, version, request, !options.Async)
+}
+
+// RollbackVersion rolls the application version back from fromStage, its current stage, to its previous stage.
+func (c *Client) RollbackVersion(ctx context.Context, applicationKey, version, fromStage string, options StageOptions) (*model.RollbackAppVersionResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.RollbackAppVersion(serviceCtx, applicationKey, version, model.NewRollbackAppVersionRequest(fromStage), !options.Async)
+}
+
+// GetVersionHistory returns the events of the application version, e.g., its promotions, oldest first.
+func (c *Client) GetVersionHistory(ctx context.Context, applicationKey, version string) ([]model.VersionHistoryEvent, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.GetAppVersionHistory(serviceCtx, applicationKey, version)
+}
+
+// DiffVersions compares the releasables of two application versions.
+func (c *Client) DiffVersions(ctx context.Context, base, target model.VersionRef) (*model.VersionDiff, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.versions.DiffAppVersions(serviceCtx, base, target)
+}
+
+// WaitForVersion polls the application version until it reaches the state of the request, and returns it.
+// It fails if the version can't reach the state, e.g., if its creation failed, or when the timeout of the request
+// passes or ctx is done. If the request does not set them, DefaultWaitTimeout and DefaultWaitInterval are used.
+func (c *Client) WaitForVersion(ctx context.Context, applicationKey, version string, request model.WaitAppVersionRequest) (*model.VersionContentResponse, error) {
+	serviceCtx, err := c.newContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Timeout <= 0 {
+		request.Timeout = DefaultWaitTimeout
+	}
+	if request.Interval <= 0 {
+		request.Interval = DefaultWaitInterval
+	}
+	return c.versions.WaitForAppVersion(serviceCtx, applicationKey, version, &request)
+}
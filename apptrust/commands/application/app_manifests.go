@@ -0,0 +1,106 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/spec"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// loadManifests reads the manifests of the file at manifestPath, or of the JSON and YAML files of the directory at
+// manifestPath, in the order of their names. The manifests are validated against the manifest schema, and each
+// application may be declared only once.
+func loadManifests(ctx *components.Context, manifestPath string) ([]model.AppManifest, error) {
+	files, err := manifestFiles(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []model.AppManifest
+	declaredIn := map[string]string{}
+	for _, file := range files {
+		content, err := utils.ReadSpecFile(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		fileManifests, err := parseManifests(file, content)
+		if err != nil {
+			return nil, err
+		}
+		for _, manifest := range fileManifests {
+			// An empty project key would make --prune list and delete the applications of all the projects.
+			if manifest.ProjectKey == "" {
+				return nil, errorutils.CheckErrorf("the manifest of application '%s' in '%s' has an empty project_key", manifest.ApplicationKey, file)
+			}
+			if otherFile, ok := declaredIn[manifest.ApplicationKey]; ok {
+				return nil, errorutils.CheckErrorf("application '%s' is declared in more than one manifest, in '%s' and in '%s'",
+					manifest.ApplicationKey, otherFile, file)
+			}
+			declaredIn[manifest.ApplicationKey] = file
+			manifests = append(manifests, manifest)
+		}
+	}
+	return manifests, nil
+}
+
+func manifestFiles(manifestPath string) ([]string, error) {
+	info, err := os.Stat(manifestPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if !info.IsDir() {
+		return []string{manifestPath}, nil
+	}
+
+	entries, err := os.ReadDir(manifestPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && slices.Contains(manifestExtensions, strings.ToLower(filepath.Ext(entry.Name()))) {
+			files = append(files, filepath.Join(manifestPath, entry.Name()))
+		}
+	}
+	if len(files) == 0 {
+		return nil, errorutils.CheckErrorf("no JSON or YAML files were found in the directory '%s'", manifestPath)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// parseManifests returns the manifests of the documents of a manifest file.
+func parseManifests(file string, content []byte) ([]model.AppManifest, error) {
+	documents, err := utils.ParseSpecDocuments(file, content)
+	if err != nil {
+		return nil, err
+	}
+	problems, err := spec.Validate(spec.SpecTypeManifest, documents)
+	if err != nil {
+		return nil, err
+	}
+	if len(problems) > 0 {
+		lines := make([]string, len(problems))
+		for i, problem := range problems {
+			lines[i] = problem.String()
+		}
+		return nil, errorutils.CheckErrorf("invalid manifest file '%s':\n%s", file, strings.Join(lines, "\n"))
+	}
+
+	manifests := make([]model.AppManifest, len(documents))
+	for i, document := range documents {
+		if err = utils.DecodeSpecNode(file, document, &manifests[i]); err != nil {
+			return nil, err
+		}
+	}
+	return manifests, nil
+}
@@ -0,0 +1,364 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	planActionCreate      = "create"
+	planActionUpdate      = "update"
+	planActionAddLabel    = "add-label"
+	planActionRemoveLabel = "remove-label"
+	planActionBind        = "bind"
+	planActionUnbind      = "unbind"
+	planActionDelete      = "delete"
+)
+
+// planChange is a change of the plan of the app-apply command.
+type planChange struct {
+	Action      string `json:"action" col-name:"Action"`
+	Application string `json:"application" col-name:"Application"`
+	// Target is the field, label or package that the change applies to.
+	Target  string `json:"target,omitempty" col-name:"Target"`
+	Details string `json:"details,omitempty" col-name:"Details"`
+}
+
+// appPlan holds the requests that bring an application to the state of its manifest, or that delete it.
+type appPlan struct {
+	applicationKey string
+	create         *model.AppDescriptor
+	update         *model.AppDescriptor
+	delete         bool
+	bind           []model.BindPackageRequest
+	unbind         []packageUnbinding
+	changes        []planChange
+}
+
+// packageUnbinding unbinds the versions of a bound package, starting from the latest one, until the latest bound
+// version is one of keep. AppTrust lists only the latest bound version of each package, so older versions are
+// found by listing the package again after each unbinding.
+type packageUnbinding struct {
+	binding model.PackageBinding
+	keep    []string
+}
+
+func (p *appPlan) addChange(action, target, details string) {
+	p.changes = append(p.changes, planChange{Action: action, Application: p.applicationKey, Target: target, Details: details})
+}
+
+func planChanges(plans []*appPlan) []planChange {
+	changes := []planChange{}
+	for _, plan := range plans {
+		changes = append(changes, plan.changes...)
+	}
+	return changes
+}
+
+// applyPlanner compares the manifests of app-apply with the applications on the server, and applies the differences.
+type applyPlanner struct {
+	ctx                service.Context
+	applicationService applications.ApplicationService
+	packageService     packages.PackageService
+	// prune deletes the applications of the projects of the manifests that aren't declared, and unbinds
+	// the packages that aren't declared.
+	prune bool
+}
+
+// plan returns the plans of the applications of the manifests, in their order, followed by the plans of the
+// applications to delete.
+func (ap *applyPlanner) plan(manifests []model.AppManifest) ([]*appPlan, error) {
+	var plans []*appPlan
+	var projects []string
+	declared := map[string]bool{}
+	for i := range manifests {
+		manifest := &manifests[i]
+		plan, err := ap.planApplication(manifest)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+		declared[manifest.ApplicationKey] = true
+		if !slices.Contains(projects, manifest.ProjectKey) {
+			projects = append(projects, manifest.ProjectKey)
+		}
+	}
+	if !ap.prune {
+		return plans, nil
+	}
+
+	for _, projectKey := range projects {
+		for application, err := range ap.applicationService.ListApplications(ap.ctx, &model.ListApplicationsRequest{ProjectKey: projectKey}) {
+			if err != nil {
+				return nil, err
+			}
+			if declared[application.ApplicationKey] {
+				continue
+			}
+			plan := &appPlan{applicationKey: application.ApplicationKey, delete: true}
+			plan.addChange(planActionDelete, "", fmt.Sprintf("not declared in project '%s'", projectKey))
+			plans = append(plans, plan)
+		}
+	}
+	return plans, nil
+}
+
+func (ap *applyPlanner) planApplication(manifest *model.AppManifest) (*appPlan, error) {
+	plan := &appPlan{applicationKey: manifest.ApplicationKey}
+	live, err := ap.applicationService.GetApplication(ap.ctx, manifest.ApplicationKey)
+	if hasStatusCode(err, http.StatusNotFound) {
+		descriptor := manifest.AppDescriptor
+		// As with app-create, the name defaults to the key, since the server requires one.
+		if descriptor.ApplicationName == "" {
+			descriptor.ApplicationName = descriptor.ApplicationKey
+		}
+		plan.create = &descriptor
+		plan.addChange(planActionCreate, "", fmt.Sprintf("in project '%s'", manifest.ProjectKey))
+		ap.planPackages(plan, manifest.Packages, nil)
+		return plan, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if live.ProjectKey != "" && live.ProjectKey != manifest.ProjectKey {
+		return nil, errorutils.CheckErrorf("application '%s' belongs to project '%s', and can't be moved to project '%s'",
+			manifest.ApplicationKey, live.ProjectKey, manifest.ProjectKey)
+	}
+
+	planDescriptorUpdate(plan, manifest, live)
+	var bound []model.PackageBinding
+	for binding, err := range ap.packageService.ListBoundPackages(ap.ctx, manifest.ApplicationKey, nil) {
+		if err != nil {
+			return nil, err
+		}
+		bound = append(bound, binding)
+	}
+	ap.planPackages(plan, manifest.Packages, bound)
+	return plan, nil
+}
+
+// planDescriptorUpdate plans the update of the descriptor fields that the manifest sets and that differ from
+// the live application. Labels are updated with label updates, so that only the changed labels are sent.
+func planDescriptorUpdate(plan *appPlan, manifest *model.AppManifest, live *model.AppDescriptor) {
+	update := &model.AppDescriptor{ApplicationKey: manifest.ApplicationKey}
+	changed := false
+	if manifest.ApplicationName != "" && manifest.ApplicationName != live.ApplicationName {
+		update.ApplicationName = manifest.ApplicationName
+		plan.addChange(planActionUpdate, "application_name", describeUpdate(live.ApplicationName, manifest.ApplicationName))
+		changed = true
+	}
+	stringFields := []struct {
+		name          string
+		desired, live *string
+		target        **string
+	}{
+		{"description", manifest.Description, live.Description, &update.Description},
+		{"maturity_level", manifest.MaturityLevel, live.MaturityLevel, &update.MaturityLevel},
+		{"criticality", manifest.BusinessCriticality, live.BusinessCriticality, &update.BusinessCriticality},
+	}
+	for _, field := range stringFields {
+		if field.desired != nil && valueOrZero(field.live) != *field.desired {
+			*field.target = field.desired
+			plan.addChange(planActionUpdate, field.name, describeUpdate(valueOrZero(field.live), *field.desired))
+			changed = true
+		}
+	}
+	ownerFields := []struct {
+		name          string
+		desired, live *[]string
+		target        **[]string
+	}{
+		{"user_owners", manifest.UserOwners, live.UserOwners, &update.UserOwners},
+		{"group_owners", manifest.GroupOwners, live.GroupOwners, &update.GroupOwners},
+	}
+	for _, field := range ownerFields {
+		if field.desired != nil && !sameElements(valueOrZero(field.live), *field.desired) {
+			*field.target = field.desired
+			plan.addChange(planActionUpdate, field.name,
+				describeUpdate(strings.Join(valueOrZero(field.live), ", "), strings.Join(*field.desired, ", ")))
+			changed = true
+		}
+	}
+
+	if manifest.Labels != nil {
+		desired, current := *manifest.Labels, valueOrZero(live.Labels)
+		labelUpdates := &model.LabelUpdates{}
+		for _, key := range slices.Sorted(maps.Keys(current)) {
+			if value, ok := desired[key]; !ok || value != current[key] {
+				labelUpdates.Remove = append(labelUpdates.Remove, model.LabelKeyValue{Key: key, Value: current[key]})
+				plan.addChange(planActionRemoveLabel, key, current[key])
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(desired)) {
+			if value, ok := current[key]; !ok || value != desired[key] {
+				labelUpdates.Add = append(labelUpdates.Add, model.LabelKeyValue{Key: key, Value: desired[key]})
+				plan.addChange(planActionAddLabel, key, desired[key])
+			}
+		}
+		if len(labelUpdates.Remove) > 0 || len(labelUpdates.Add) > 0 {
+			update.LabelUpdates = labelUpdates
+			changed = true
+		}
+	}
+	if changed {
+		plan.update = update
+	}
+}
+
+// planPackages plans the binding of the declared package versions that aren't the latest bound version of their
+// package, and with prune, the unbinding of the bound versions that aren't declared. Since only the latest bound
+// version of each package is listed, the binding of an older version that is already bound is planned, and is
+// skipped when the plan is applied.
+func (ap *applyPlanner) planPackages(plan *appPlan, declared []model.ManifestPackage, bound []model.PackageBinding) {
+	boundByName := map[string]model.PackageBinding{}
+	for _, binding := range bound {
+		boundByName[packageName(binding.Type, binding.Name)] = binding
+	}
+	declaredVersions := map[string][]string{}
+	for _, pkg := range declared {
+		name := packageName(pkg.Type, pkg.Name)
+		declaredVersions[name] = append(declaredVersions[name], pkg.Version)
+		if binding, ok := boundByName[name]; ok && binding.LatestVersion == pkg.Version {
+			continue
+		}
+		plan.bind = append(plan.bind, model.BindPackageRequest{Type: pkg.Type, Name: pkg.Name, Version: pkg.Version})
+		plan.addChange(planActionBind, name, pkg.Version)
+	}
+	if !ap.prune {
+		return
+	}
+
+	for _, binding := range bound {
+		name := packageName(binding.Type, binding.Name)
+		versions, ok := declaredVersions[name]
+		switch {
+		case !ok:
+			plan.unbind = append(plan.unbind, packageUnbinding{binding: binding})
+			details := binding.LatestVersion
+			if binding.NumVersions > 1 {
+				details = fmt.Sprintf("all %d versions", binding.NumVersions)
+			}
+			plan.addChange(planActionUnbind, name, details)
+		case !slices.Contains(versions, binding.LatestVersion):
+			plan.unbind = append(plan.unbind, packageUnbinding{binding: binding, keep: versions})
+			plan.addChange(planActionUnbind, name, binding.LatestVersion)
+		}
+	}
+}
+
+// apply runs the requests of the plans, in their order. It stops at the first request that fails.
+func (ap *applyPlanner) apply(plans []*appPlan) error {
+	for _, plan := range plans {
+		if err := ap.applyApplication(plan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ap *applyPlanner) applyApplication(plan *appPlan) error {
+	applicationKey := plan.applicationKey
+	switch {
+	case plan.create != nil:
+		if _, err := ap.applicationService.CreateApplication(ap.ctx, plan.create); err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Application \"%s\" created successfully.", applicationKey))
+	case plan.update != nil:
+		if _, err := ap.applicationService.UpdateApplication(ap.ctx, plan.update); err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Application \"%s\" updated successfully.", applicationKey))
+	case plan.delete:
+		if err := ap.applicationService.DeleteApplication(ap.ctx, applicationKey); err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Application \"%s\" deleted successfully.", applicationKey))
+	}
+
+	for _, request := range plan.bind {
+		_, err := ap.packageService.BindPackage(ap.ctx, applicationKey, &request)
+		if hasStatusCode(err, http.StatusConflict) {
+			log.Debug(fmt.Sprintf("Package %s:%s is already bound to application \"%s\".", packageName(request.Type, request.Name), request.Version, applicationKey))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Package %s:%s bound to application \"%s\".", packageName(request.Type, request.Name), request.Version, applicationKey))
+	}
+	for _, unbinding := range plan.unbind {
+		if err := ap.unbindVersions(applicationKey, unbinding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ap *applyPlanner) unbindVersions(applicationKey string, unbinding packageUnbinding) error {
+	binding := &unbinding.binding
+	for i := 0; i < unbinding.binding.NumVersions && binding != nil && !slices.Contains(unbinding.keep, binding.LatestVersion); i++ {
+		if err := ap.packageService.UnbindPackage(ap.ctx, applicationKey, binding.Type, binding.Name, binding.LatestVersion); err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Package %s:%s unbound from application \"%s\".", packageName(binding.Type, binding.Name), binding.LatestVersion, applicationKey))
+
+		var err error
+		if binding, err = ap.findBinding(applicationKey, binding.Type, binding.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findBinding returns the binding of the package, or nil if no version of the package is bound.
+func (ap *applyPlanner) findBinding(applicationKey, packageType, name string) (*model.PackageBinding, error) {
+	request := &model.ListBoundPackagesRequest{Type: packageType}
+	for binding, err := range ap.packageService.ListBoundPackages(ap.ctx, applicationKey, request) {
+		if err != nil {
+			return nil, err
+		}
+		if binding.Type == packageType && binding.Name == name {
+			return &binding, nil
+		}
+	}
+	return nil, nil
+}
+
+func packageName(packageType, name string) string {
+	return packageType + "/" + name
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apptrustErr *apphttp.ApptrustError
+	return errors.As(err, &apptrustErr) && apptrustErr.StatusCode == statusCode
+}
+
+func describeUpdate(from, to string) string {
+	return fmt.Sprintf("from %q to %q", from, to)
+}
+
+func valueOrZero[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
+	}
+	return *value
+}
+
+// sameElements returns whether a and b have the same elements, in any order.
+func sameElements(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
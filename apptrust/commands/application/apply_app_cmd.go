@@ -0,0 +1,128 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type applyAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
+	applicationService applications.ApplicationService
+	packageService     packages.PackageService
+	manifests          []model.AppManifest
+	autoApprove        bool
+	prune              bool
+	format             string
+}
+
+func (aac *applyAppCommand) Run() error {
+	ctx, err := service.NewContext(*aac.serverDetails, aac.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	planner := &applyPlanner{
+		ctx:                ctx,
+		applicationService: aac.applicationService,
+		packageService:     aac.packageService,
+		prune:              aac.prune,
+	}
+	plans, err := planner.plan(aac.manifests)
+	if err != nil {
+		return err
+	}
+	changes := planChanges(plans)
+	if err = output.PrintWithTable(aac.format, changes, changes, "No changes. The applications match the manifests."); err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	if !aac.autoApprove {
+		log.Info(fmt.Sprintf("Run the command with --%s to apply the plan.", commands.AutoApproveFlag))
+		return nil
+	}
+
+	if err = planner.apply(plans); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Applied %d change(s).", len(changes)))
+	return nil
+}
+
+func (aac *applyAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return aac.serverDetails, nil
+}
+
+func (aac *applyAppCommand) CommandName() string {
+	return commands.AppApply
+}
+
+func (aac *applyAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	aac.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(aac.format); err != nil {
+		return err
+	}
+	manifestPath := ctx.GetStringFlagValue(commands.FileFlag)
+	if manifestPath == "" {
+		return errorutils.CheckErrorf("the --%s flag is mandatory", commands.FileFlag)
+	}
+	aac.autoApprove = ctx.GetBoolFlagValue(commands.AutoApproveFlag)
+	aac.prune = ctx.GetBoolFlagValue(commands.PruneFlag)
+
+	var err error
+	aac.manifests, err = loadManifests(ctx, manifestPath)
+	if err != nil {
+		return err
+	}
+
+	aac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	aac.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(aac)
+}
+
+func GetApplyAppCommand(appContext app.Context) components.Command {
+	cmd := &applyAppCommand{
+		applicationService: appContext.GetApplicationService(),
+		packageService:     appContext.GetPackageService(),
+	}
+	return components.Command{
+		Name:        commands.AppApply,
+		Description: "Apply application manifests. Compares the manifests with the applications on the server and prints the plan of the changes: creates, updates, label additions and removals, and package bindings and unbindings. The plan is applied with --auto-approve.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"aa"},
+		Arguments:   []components.Argument{},
+		Flags:       commands.GetCommandFlags(commands.AppApply),
+		Action:      cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,226 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/fakeserver"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startApplyServer starts a test server with the "web" and "legacy" applications of project "proj", and the
+// "other" application of project "other-proj". The npm/legacy-ui package is bound to "web".
+func startApplyServer(t *testing.T) (*coreConfig.ServerDetails, *applyPlanner) {
+	server, serverDetails := fakeserver.StartTestServer(t)
+	maturityLevel := model.MaturityLevelExperimental
+	require.NoError(t, server.Seed(fakeserver.SeedData{
+		Applications: []model.AppDescriptor{
+			{ApplicationKey: "web", ProjectKey: "proj", ApplicationName: "web", MaturityLevel: &maturityLevel, Labels: &map[string]string{"team": "web", "env": "dev"}},
+			{ApplicationKey: "legacy", ProjectKey: "proj"},
+			{ApplicationKey: "other", ProjectKey: "other-proj"},
+		},
+	}))
+
+	ctx, err := service.NewContext(*serverDetails, apphttp.WithRetries(0))
+	require.NoError(t, err)
+	planner := &applyPlanner{
+		ctx:                ctx,
+		applicationService: applications.NewApplicationService(),
+		packageService:     packages.NewPackageService(),
+	}
+	_, err = planner.packageService.BindPackage(ctx, "web", &model.BindPackageRequest{Type: "npm", Name: "legacy-ui", Version: "0.9.0"})
+	require.NoError(t, err)
+	return serverDetails, planner
+}
+
+func loadTestManifests(t *testing.T) []model.AppManifest {
+	content, err := os.ReadFile(filepath.Join("testfiles", "apps-manifest.yaml"))
+	require.NoError(t, err)
+	manifests, err := parseManifests("apps-manifest.yaml", content)
+	require.NoError(t, err)
+	return manifests
+}
+
+func TestApplyPlanner_Plan(t *testing.T) {
+	_, planner := startApplyServer(t)
+
+	plans, err := planner.plan(loadTestManifests(t))
+	require.NoError(t, err)
+	assert.Equal(t, []planChange{
+		{Action: planActionUpdate, Application: "web", Target: "application_name", Details: `from "web" to "Web"`},
+		{Action: planActionUpdate, Application: "web", Target: "maturity_level", Details: `from "experimental" to "production"`},
+		{Action: planActionRemoveLabel, Application: "web", Target: "env", Details: "dev"},
+		{Action: planActionAddLabel, Application: "web", Target: "env", Details: "prod"},
+		{Action: planActionBind, Application: "web", Target: "npm/web-ui", Details: "1.2.0"},
+		{Action: planActionCreate, Application: "api", Details: "in project 'proj'"},
+		{Action: planActionBind, Application: "api", Target: "docker/api", Details: "2.0.0"},
+	}, planChanges(plans))
+
+	planner.prune = true
+	plans, err = planner.plan(loadTestManifests(t))
+	require.NoError(t, err)
+	changes := planChanges(plans)
+	assert.Contains(t, changes, planChange{Action: planActionUnbind, Application: "web", Target: "npm/legacy-ui", Details: "0.9.0"})
+	assert.Equal(t, planChange{Action: planActionDelete, Application: "legacy", Details: "not declared in project 'proj'"}, changes[len(changes)-1])
+	assert.NotContains(t, changes, planChange{Action: planActionDelete, Application: "other", Details: "not declared in project 'proj'"})
+}
+
+func TestApplyPlanner_Apply(t *testing.T) {
+	_, planner := startApplyServer(t)
+	planner.prune = true
+
+	plans, err := planner.plan(loadTestManifests(t))
+	require.NoError(t, err)
+	require.NoError(t, planner.apply(plans))
+
+	web, err := planner.applicationService.GetApplication(planner.ctx, "web")
+	require.NoError(t, err)
+	assert.Equal(t, "Web", web.ApplicationName)
+	assert.Equal(t, model.MaturityLevelProduction, *web.MaturityLevel)
+	assert.Equal(t, map[string]string{"team": "web", "env": "prod"}, *web.Labels)
+	assert.Equal(t, []model.PackageBinding{{Type: "npm", Name: "web-ui", NumVersions: 1, LatestVersion: "1.2.0"}}, listBindings(t, planner, "web"))
+
+	api, err := planner.applicationService.GetApplication(planner.ctx, "api")
+	require.NoError(t, err)
+	assert.Equal(t, "api", api.ApplicationName, "the name defaults to the key")
+	assert.Equal(t, model.BusinessCriticalityHigh, *api.BusinessCriticality)
+	assert.Equal(t, []string{"jane"}, *api.UserOwners)
+	assert.Equal(t, []model.PackageBinding{{Type: "docker", Name: "api", NumVersions: 1, LatestVersion: "2.0.0"}}, listBindings(t, planner, "api"))
+
+	_, err = planner.applicationService.GetApplication(planner.ctx, "legacy")
+	assert.True(t, hasStatusCode(err, 404), "legacy was not deleted: %v", err)
+	_, err = planner.applicationService.GetApplication(planner.ctx, "other")
+	assert.NoError(t, err)
+
+	// The applications match the manifests once the plan is applied.
+	plans, err = planner.plan(loadTestManifests(t))
+	require.NoError(t, err)
+	assert.Empty(t, planChanges(plans))
+}
+
+func TestApplyPlanner_UnbindUndeclaredVersions(t *testing.T) {
+	_, planner := startApplyServer(t)
+	planner.prune = true
+	for _, version := range []string{"1.0.0", "1.1.0", "1.2.0"} {
+		_, err := planner.packageService.BindPackage(planner.ctx, "legacy", &model.BindPackageRequest{Type: "npm", Name: "legacy-ui", Version: version})
+		require.NoError(t, err)
+	}
+	manifests := []model.AppManifest{{
+		AppDescriptor: model.AppDescriptor{ApplicationKey: "legacy", ProjectKey: "proj"},
+		Packages:      []model.ManifestPackage{{Type: "npm", Name: "legacy-ui", Version: "1.0.0"}},
+	}}
+
+	plans, err := planner.plan(manifests)
+	require.NoError(t, err)
+	changes := planChanges(plans)
+	assert.Contains(t, changes, planChange{Action: planActionBind, Application: "legacy", Target: "npm/legacy-ui", Details: "1.0.0"})
+	assert.Contains(t, changes, planChange{Action: planActionUnbind, Application: "legacy", Target: "npm/legacy-ui", Details: "1.2.0"})
+
+	// Binding the version that is already bound is skipped, and the newer versions are unbound.
+	require.NoError(t, planner.apply(plans))
+	assert.Equal(t, []model.PackageBinding{{Type: "npm", Name: "legacy-ui", NumVersions: 1, LatestVersion: "1.0.0"}}, listBindings(t, planner, "legacy"))
+}
+
+func TestApplyPlanner_Plan_ProjectChange(t *testing.T) {
+	_, planner := startApplyServer(t)
+	manifests := []model.AppManifest{{AppDescriptor: model.AppDescriptor{ApplicationKey: "other", ProjectKey: "proj"}}}
+
+	_, err := planner.plan(manifests)
+	assert.EqualError(t, err, "application 'other' belongs to project 'other-proj', and can't be moved to project 'proj'")
+}
+
+func TestApplyAppCommand_Run(t *testing.T) {
+	serverDetails, planner := startApplyServer(t)
+	cmd := &applyAppCommand{
+		serverDetails:      serverDetails,
+		clientOptions:      []apphttp.ClientOption{apphttp.WithRetries(0)},
+		applicationService: planner.applicationService,
+		packageService:     planner.packageService,
+		manifests:          loadTestManifests(t),
+		format:             output.FormatJson,
+	}
+
+	// Without --auto-approve, the plan is only printed.
+	require.NoError(t, cmd.Run())
+	_, err := planner.applicationService.GetApplication(planner.ctx, "api")
+	assert.True(t, hasStatusCode(err, 404), "api was created: %v", err)
+
+	cmd.autoApprove = true
+	require.NoError(t, cmd.Run())
+	_, err = planner.applicationService.GetApplication(planner.ctx, "api")
+	assert.NoError(t, err)
+}
+
+func TestApplyAppCommand_MissingFileFlag(t *testing.T) {
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.FormatFlag, output.FormatTable)
+
+	cmd := &applyAppCommand{}
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.EqualError(t, err, "the --file flag is mandatory")
+}
+
+func TestLoadManifests(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	writeFile("b.json", `{"application_key": "b", "project_key": "proj"}`)
+	writeFile("a.yaml", "application_key: a\nproject_key: proj\n---\napplication_key: c\nproject_key: ${project}\n")
+	writeFile("README.md", "Not a manifest.")
+
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.SpecVarsFlag, "project=other-proj")
+	manifests, err := loadManifests(ctx, dir)
+	require.NoError(t, err)
+	require.Len(t, manifests, 3)
+	assert.Equal(t, "a", manifests[0].ApplicationKey)
+	assert.Equal(t, "c", manifests[1].ApplicationKey)
+	assert.Equal(t, "other-proj", manifests[1].ProjectKey)
+	assert.Equal(t, "b", manifests[2].ApplicationKey)
+
+	writeFile("c.yml", "application_key: c\nproject_key: proj\n")
+	_, err = loadManifests(ctx, dir)
+	assert.EqualError(t, err, "application 'c' is declared in more than one manifest, in '"+filepath.Join(dir, "a.yaml")+"' and in '"+filepath.Join(dir, "c.yml")+"'")
+
+	_, err = loadManifests(ctx, t.TempDir())
+	assert.ErrorContains(t, err, "no JSON or YAML files were found in the directory")
+}
+
+func TestLoadManifests_EmptyProjectKey(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "apps.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte("application_key: web\nproject_key: \"\"\n"), 0644))
+
+	_, err := loadManifests(&components.Context{}, manifestPath)
+	assert.EqualError(t, err, "the manifest of application 'web' in '"+manifestPath+"' has an empty project_key")
+}
+
+func TestParseManifests_Invalid(t *testing.T) {
+	content := "application_key: web\nproject_key: proj\nmaturity_level: prod\n---\nproject_key: proj\npackages:\n  - {type: npm, name: web-ui}\n"
+
+	_, err := parseManifests("apps.yaml", []byte(content))
+	assert.EqualError(t, err, "invalid manifest file 'apps.yaml':\n"+
+		"line 3, column 17: maturity_level: invalid value 'prod'. Allowed values: unspecified, experimental, production and end_of_life\n"+
+		"line 7, column 5: packages[0]: missing required field 'version'\n"+
+		"line 5, column 1: missing required field 'application_key'")
+}
+
+func listBindings(t *testing.T, planner *applyPlanner, applicationKey string) []model.PackageBinding {
+	var bindings []model.PackageBinding
+	for binding, err := range planner.packageService.ListBoundPackages(planner.ctx, applicationKey, nil) {
+		require.NoError(t, err)
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
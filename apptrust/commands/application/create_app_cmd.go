@@ -1,19 +1,19 @@
 package application
 
 import (
-	"encoding/json"
+	"fmt"
 
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
-	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
-	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
@@ -23,17 +23,24 @@ import (
 
 type createAppCommand struct {
 	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
 	applicationService applications.ApplicationService
 	requestBody        *model.AppDescriptor
+	format             string
 }
 
 func (cac *createAppCommand) Run() error {
-	ctx, err := service.NewContext(*cac.serverDetails)
+	ctx, err := service.NewContext(*cac.serverDetails, cac.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return cac.applicationService.CreateApplication(ctx, cac.requestBody)
+	application, err := cac.applicationService.CreateApplication(ctx, cac.requestBody)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Application \"%s\" created successfully.", cac.requestBody.ApplicationKey))
+	return output.Print(cac.format, application)
 }
 
 func (cac *createAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -87,20 +94,8 @@ func (cac *createAppCommand) buildFromFlags(ctx *components.Context) (*model.App
 }
 
 func (cac *createAppCommand) loadFromSpec(ctx *components.Context) (*model.AppDescriptor, error) {
-	specFilePath := ctx.GetStringFlagValue(commands.SpecFlag)
 	spec := new(model.AppDescriptor)
-	specVars := coreutils.SpecVarsStringToMap(ctx.GetStringFlagValue(commands.SpecVarsFlag))
-	content, err := fileutils.ReadFile(specFilePath)
-	if errorutils.CheckError(err) != nil {
-		return nil, err
-	}
-
-	if len(specVars) > 0 {
-		content = coreutils.ReplaceVars(content, specVars)
-	}
-
-	err = json.Unmarshal(content, spec)
-	if errorutils.CheckError(err) != nil {
+	if err := utils.LoadSpecFile(ctx, spec); err != nil {
 		return nil, err
 	}
 
@@ -116,6 +111,11 @@ func (cac *createAppCommand) prepareAndRunCommand(ctx *components.Context) error
 		return err
 	}
 
+	cac.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(cac.format); err != nil {
+		return err
+	}
+
 	var err error
 	cac.requestBody, err = cac.buildRequestPayload(ctx)
 	if err != nil {
@@ -126,8 +126,14 @@ func (cac *createAppCommand) prepareAndRunCommand(ctx *components.Context) error
 	if err != nil {
 		return err
 	}
+	var release func()
+	cac.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 
-	return commonCLiCommands.Exec(cac)
+	return utils.ExecCommand(ctx, cac)
 }
 
 func validateCreateAppContext(ctx *components.Context) error {
@@ -52,7 +52,7 @@ func TestCreateAppCommand_Run_Flags(t *testing.T) {
 	}
 
 	mockAppService := mockapps.NewMockApplicationService(ctrl)
-	mockAppService.EXPECT().CreateApplication(gomock.Any(), requestPayload).Return(nil).Times(1)
+	mockAppService.EXPECT().CreateApplication(gomock.Any(), requestPayload).Return(nil, nil).Times(1)
 
 	cmd := &createAppCommand{
 		applicationService: mockAppService,
@@ -75,7 +75,7 @@ func TestCreateAppCommand_Error(t *testing.T) {
 	}
 
 	mockAppService := mockapps.NewMockApplicationService(ctrl)
-	mockAppService.EXPECT().CreateApplication(gomock.Any(), requestPayload).Return(errors.New("failed to create an application. Status code: 500")).Times(1)
+	mockAppService.EXPECT().CreateApplication(gomock.Any(), requestPayload).Return(nil, errors.New("failed to create an application. Status code: 500")).Times(1)
 
 	cmd := &createAppCommand{
 		applicationService: mockAppService,
@@ -161,9 +161,9 @@ func TestCreateAppCommand_Run_FullSpecFile(t *testing.T) {
 	var actualPayload *model.AppDescriptor
 	mockAppService := mockapps.NewMockApplicationService(ctrl)
 	mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) (*model.AppDescriptor, error) {
 			actualPayload = req
-			return nil
+			return nil, nil
 		}).Times(1)
 
 	cmd := &createAppCommand{
@@ -201,6 +201,23 @@ func TestCreateAppCommand_Run_SpecFile(t *testing.T) {
 			expectsError:  true,
 			errorContains: "unexpected end of JSON input",
 		},
+		{
+			name:     "minimal YAML spec file",
+			specPath: "./testfiles/minimal-spec.yaml",
+			args:     []string{"app-min"},
+			expectsPayload: &model.AppDescriptor{
+				ApplicationKey:  "app-min",
+				ApplicationName: "app-min",
+				ProjectKey:      "test-project",
+			},
+		},
+		{
+			name:          "invalid type in YAML spec file",
+			specPath:      "./testfiles/invalid-type-spec.yaml",
+			args:          []string{"app-invalid"},
+			expectsError:  true,
+			errorContains: "line 3, column 14: 'user_owners' must be a list, found string",
+		},
 		{
 			name:          "missing project key",
 			specPath:      "./testfiles/missing-project-spec.json",
@@ -242,9 +259,9 @@ func TestCreateAppCommand_Run_SpecFile(t *testing.T) {
 			mockAppService := mockapps.NewMockApplicationService(ctrl)
 			if !tt.expectsError {
 				mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+					DoAndReturn(func(_ interface{}, req *model.AppDescriptor) (*model.AppDescriptor, error) {
 						actualPayload = req
-						return nil
+						return nil, nil
 					}).Times(1)
 			}
 
@@ -297,9 +314,48 @@ func TestCreateAppCommand_Run_SpecVars(t *testing.T) {
 	var actualPayload *model.AppDescriptor
 	mockAppService := mockapps.NewMockApplicationService(ctrl)
 	mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) (*model.AppDescriptor, error) {
+			actualPayload = req
+			return nil, nil
+		}).Times(1)
+
+	cmd := &createAppCommand{
+		applicationService: mockAppService,
+	}
+
+	err := cmd.prepareAndRunCommand(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPayload, actualPayload)
+}
+
+func TestCreateAppCommand_Run_YamlSpecVars(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedDescription := "A test application for production"
+	expectedPayload := &model.AppDescriptor{
+		ApplicationKey:  "app-with-vars",
+		ApplicationName: "test-app",
+		ProjectKey:      "test-project",
+		Description:     &expectedDescription,
+		Labels: &map[string]string{
+			"environment": "production",
+		},
+	}
+
+	ctx := &components.Context{
+		Arguments: []string{"app-with-vars"},
+	}
+	ctx.AddStringFlag("spec", "./testfiles/with-vars-spec.yml")
+	ctx.AddStringFlag("spec-vars", "PROJECT_KEY=test-project;APP_NAME=test-app;ENVIRONMENT=production")
+	ctx.AddStringFlag("url", "https://example.com")
+
+	var actualPayload *model.AppDescriptor
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *model.AppDescriptor) (*model.AppDescriptor, error) {
 			actualPayload = req
-			return nil
+			return nil, nil
 		}).Times(1)
 
 	cmd := &createAppCommand{
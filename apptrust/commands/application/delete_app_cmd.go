@@ -1,32 +1,40 @@
 package application
 
 import (
+	"fmt"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type deleteAppCommand struct {
 	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
 	applicationService applications.ApplicationService
 	applicationKey     string
 }
 
 func (dac *deleteAppCommand) Run() error {
-	ctx, err := service.NewContext(*dac.serverDetails)
+	ctx, err := service.NewContext(*dac.serverDetails, dac.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return dac.applicationService.DeleteApplication(ctx, dac.applicationKey)
+	if err = dac.applicationService.DeleteApplication(ctx, dac.applicationKey); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Application \"%s\" deleted successfully.", dac.applicationKey))
+	return nil
 }
 
 func (dac *deleteAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -49,8 +57,14 @@ func (dac *deleteAppCommand) prepareAndRunCommand(ctx *components.Context) error
 	if err != nil {
 		return err
 	}
+	var release func()
+	dac.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 
-	return commonCLiCommands.Exec(dac)
+	return utils.ExecCommand(ctx, dac)
 }
 
 func GetDeleteAppCommand(appContext app.Context) components.Command {
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// yamlDocumentSeparator separates the specs of the applications in a multi-document YAML file.
+var yamlDocumentSeparator = []byte("---\n")
+
+type exportAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
+	applicationService applications.ApplicationService
+	packageService     packages.PackageService
+	applicationKey     string
+	projectKey         string
+	includePackages    bool
+	outputDir          string
+	outputFile         string
+}
+
+func (eac *exportAppCommand) Run() error {
+	ctx, err := service.NewContext(*eac.serverDetails, eac.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	descriptors, err := eac.getApplications(ctx)
+	if err != nil {
+		return err
+	}
+	if len(descriptors) == 0 {
+		log.Info(fmt.Sprintf("No applications were found in project \"%s\".", eac.projectKey))
+		return nil
+	}
+	specs := make([][]byte, len(descriptors))
+	for i, descriptor := range descriptors {
+		manifest, err := eac.toManifest(ctx, descriptor)
+		if err != nil {
+			return err
+		}
+		if specs[i], err = output.MarshalYaml(manifest); err != nil {
+			return err
+		}
+	}
+	return eac.writeSpecs(descriptors, specs)
+}
+
+// getApplications returns the application of the application key, or the applications of the project, sorted by key.
+func (eac *exportAppCommand) getApplications(ctx service.Context) ([]model.AppDescriptor, error) {
+	if eac.applicationKey != "" {
+		descriptor, err := eac.applicationService.GetApplication(ctx, eac.applicationKey)
+		if err != nil {
+			return nil, err
+		}
+		return []model.AppDescriptor{*descriptor}, nil
+	}
+
+	var descriptors []model.AppDescriptor
+	for descriptor, err := range eac.applicationService.ListApplications(ctx, &model.ListApplicationsRequest{ProjectKey: eac.projectKey}) {
+		if err != nil {
+			return nil, err
+		}
+		descriptors = append(descriptors, descriptor)
+	}
+	sort.Slice(descriptors, func(i, j int) bool {
+		return descriptors[i].ApplicationKey < descriptors[j].ApplicationKey
+	})
+	return descriptors, nil
+}
+
+// toManifest returns the spec of the application, which app-create --spec and app-apply accept.
+// AppTrust lists only the latest bound version of each package, so the older bound versions aren't exported.
+func (eac *exportAppCommand) toManifest(ctx service.Context, descriptor model.AppDescriptor) (model.AppManifest, error) {
+	descriptor.LabelUpdates = nil
+	manifest := model.AppManifest{AppDescriptor: descriptor}
+	if !eac.includePackages {
+		return manifest, nil
+	}
+	for binding, err := range eac.packageService.ListBoundPackages(ctx, descriptor.ApplicationKey, nil) {
+		if err != nil {
+			return model.AppManifest{}, err
+		}
+		if binding.NumVersions > 1 {
+			log.Warn(fmt.Sprintf("Application \"%s\" has %d bound versions of the %s package \"%s\", but only the latest version, %s, is exported.",
+				descriptor.ApplicationKey, binding.NumVersions, binding.Type, binding.Name, binding.LatestVersion))
+		}
+		manifest.Packages = append(manifest.Packages, model.ManifestPackage{Type: binding.Type, Name: binding.Name, Version: binding.LatestVersion})
+	}
+	return manifest, nil
+}
+
+func (eac *exportAppCommand) writeSpecs(descriptors []model.AppDescriptor, specs [][]byte) error {
+	switch {
+	case eac.outputDir != "":
+		if err := os.MkdirAll(eac.outputDir, 0755); err != nil {
+			return errorutils.CheckError(err)
+		}
+		for i, descriptor := range descriptors {
+			specFilePath := filepath.Join(eac.outputDir, descriptor.ApplicationKey+".yaml")
+			if err := os.WriteFile(specFilePath, specs[i], 0644); err != nil {
+				return errorutils.CheckError(err)
+			}
+		}
+		log.Info(fmt.Sprintf("Exported %d application(s) to the directory \"%s\".", len(specs), eac.outputDir))
+	case eac.outputFile != "":
+		if err := os.WriteFile(eac.outputFile, bytes.Join(specs, yamlDocumentSeparator), 0644); err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Info(fmt.Sprintf("Exported %d application(s) to the file \"%s\".", len(specs), eac.outputFile))
+	default:
+		log.Output(strings.TrimSuffix(string(bytes.Join(specs, yamlDocumentSeparator)), "\n"))
+	}
+	return nil
+}
+
+func (eac *exportAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return eac.serverDetails, nil
+}
+
+func (eac *exportAppCommand) CommandName() string {
+	return commands.AppExport
+}
+
+func (eac *exportAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) > 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if len(ctx.Arguments) == 1 {
+		eac.applicationKey = ctx.Arguments[0]
+	}
+	eac.projectKey = ctx.GetStringFlagValue(commands.ProjectFlag)
+	switch {
+	case eac.applicationKey == "" && eac.projectKey == "":
+		return errorutils.CheckErrorf("an application key or the --%s option is mandatory", commands.ProjectFlag)
+	case eac.applicationKey != "" && eac.projectKey != "":
+		return errorutils.CheckErrorf("the --%s option is not allowed with an application key", commands.ProjectFlag)
+	}
+	eac.outputDir = ctx.GetStringFlagValue(commands.DirFlag)
+	eac.outputFile = ctx.GetStringFlagValue(commands.FileFlag)
+	if eac.outputDir != "" && eac.outputFile != "" {
+		return errorutils.CheckErrorf("the --%s and --%s options are not allowed together", commands.DirFlag, commands.FileFlag)
+	}
+	eac.includePackages = ctx.GetBoolFlagValue(commands.IncludePackagesFlag)
+
+	var err error
+	eac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	eac.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(eac)
+}
+
+func GetExportAppCommand(appContext app.Context) components.Command {
+	cmd := &exportAppCommand{
+		applicationService: appContext.GetApplicationService(),
+		packageService:     appContext.GetPackageService(),
+	}
+	return components.Command{
+		Name:        commands.AppExport,
+		Description: "Export an application, or all the applications of a project, as YAML spec files that app-create --spec and app-apply accept.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"ae"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The key of the application to export. Omit it to export the applications of the project of --project.",
+				Optional:    true,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.AppExport),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
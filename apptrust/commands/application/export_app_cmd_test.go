@@ -0,0 +1,162 @@
+package application
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportAppCommand(t *testing.T) (*exportAppCommand, *applyPlanner) {
+	serverDetails, planner := startApplyServer(t)
+	return &exportAppCommand{
+		serverDetails:      serverDetails,
+		clientOptions:      []apphttp.ClientOption{apphttp.WithRetries(0)},
+		applicationService: planner.applicationService,
+		packageService:     planner.packageService,
+	}, planner
+}
+
+func TestExportAppCommand_Run_Dir(t *testing.T) {
+	cmd, planner := newExportAppCommand(t)
+	cmd.projectKey = "proj"
+	cmd.includePackages = true
+	cmd.outputDir = filepath.Join(t.TempDir(), "apps")
+	require.NoError(t, cmd.Run())
+
+	entries, err := os.ReadDir(cmd.outputDir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.Equal(t, []string{"legacy.yaml", "web.yaml"}, names)
+
+	content, err := os.ReadFile(filepath.Join(cmd.outputDir, "web.yaml"))
+	require.NoError(t, err)
+	manifests, err := parseManifests("web.yaml", content)
+	require.NoError(t, err)
+	maturityLevel := model.MaturityLevelExperimental
+	assert.Equal(t, []model.AppManifest{{
+		AppDescriptor: model.AppDescriptor{
+			ApplicationKey:  "web",
+			ApplicationName: "web",
+			ProjectKey:      "proj",
+			MaturityLevel:   &maturityLevel,
+			Labels:          &map[string]string{"team": "web", "env": "dev"},
+		},
+		Packages: []model.ManifestPackage{{Type: "npm", Name: "legacy-ui", Version: "0.9.0"}},
+	}}, manifests)
+
+	// The spec is accepted by app-create --spec.
+	descriptor := new(model.AppDescriptor)
+	require.NoError(t, utils.DecodeSpec("web.yaml", content, descriptor))
+	assert.Equal(t, manifests[0].AppDescriptor, *descriptor)
+
+	// The exported applications match their specs.
+	planner.prune = true
+	plans, err := planner.plan(mustLoadManifests(t, cmd.outputDir))
+	require.NoError(t, err)
+	assert.Empty(t, planChanges(plans))
+}
+
+func TestExportAppCommand_Run_File(t *testing.T) {
+	cmd, _ := newExportAppCommand(t)
+	cmd.projectKey = "proj"
+	cmd.outputFile = filepath.Join(t.TempDir(), "apps.yaml")
+	require.NoError(t, cmd.Run())
+
+	manifests := mustLoadManifests(t, cmd.outputFile)
+	require.Len(t, manifests, 2)
+	assert.Equal(t, "legacy", manifests[0].ApplicationKey)
+	assert.Equal(t, "web", manifests[1].ApplicationKey)
+	assert.Nil(t, manifests[1].Packages)
+
+	cmd.projectKey = ""
+	cmd.applicationKey = "other"
+	require.NoError(t, cmd.Run())
+	manifests = mustLoadManifests(t, cmd.outputFile)
+	require.Len(t, manifests, 1)
+	assert.Equal(t, "other-proj", manifests[0].ProjectKey)
+}
+
+// TestExportAppCommand_Run_OlderBoundVersions checks that only the latest bound version of a package is exported,
+// with a warning about the older ones.
+func TestExportAppCommand_Run_OlderBoundVersions(t *testing.T) {
+	cmd, planner := newExportAppCommand(t)
+	_, err := planner.packageService.BindPackage(planner.ctx, "web", &model.BindPackageRequest{Type: "npm", Name: "legacy-ui", Version: "1.0.0"})
+	require.NoError(t, err)
+	var logOutput bytes.Buffer
+	previousLogger := log.GetLogger()
+	log.SetLogger(log.NewLogger(log.INFO, &logOutput))
+	t.Cleanup(func() { log.SetLogger(previousLogger) })
+
+	cmd.applicationKey = "web"
+	cmd.includePackages = true
+	cmd.outputFile = filepath.Join(t.TempDir(), "web.yaml")
+	require.NoError(t, cmd.Run())
+
+	manifests := mustLoadManifests(t, cmd.outputFile)
+	require.Len(t, manifests, 1)
+	assert.Equal(t, []model.ManifestPackage{{Type: "npm", Name: "legacy-ui", Version: "1.0.0"}}, manifests[0].Packages)
+	assert.Contains(t, logOutput.String(), `Application "web" has 2 bound versions of the npm package "legacy-ui", but only the latest version, 1.0.0, is exported.`)
+}
+
+func TestExportAppCommand_Run_EmptyProject(t *testing.T) {
+	cmd, _ := newExportAppCommand(t)
+	cmd.projectKey = "empty-proj"
+	cmd.outputFile = filepath.Join(t.TempDir(), "apps.yaml")
+	require.NoError(t, cmd.Run())
+	assert.NoFileExists(t, cmd.outputFile)
+}
+
+func TestExportAppCommand_InvalidArguments(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     []string
+		flags         map[string]string
+		expectedError string
+	}{
+		{
+			name:          "no application key or project",
+			expectedError: "an application key or the --project option is mandatory",
+		},
+		{
+			name:          "application key and project",
+			arguments:     []string{"web"},
+			flags:         map[string]string{commands.ProjectFlag: "proj"},
+			expectedError: "the --project option is not allowed with an application key",
+		},
+		{
+			name:          "directory and file",
+			flags:         map[string]string{commands.ProjectFlag: "proj", commands.DirFlag: "apps", commands.FileFlag: "apps.yaml"},
+			expectedError: "the --dir and --file options are not allowed together",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{Arguments: tt.arguments}
+			for flag, value := range tt.flags {
+				ctx.AddStringFlag(flag, value)
+			}
+			cmd := &exportAppCommand{}
+			assert.EqualError(t, cmd.prepareAndRunCommand(ctx), tt.expectedError)
+		})
+	}
+}
+
+func mustLoadManifests(t *testing.T, manifestPath string) []model.AppManifest {
+	manifests, err := loadManifests(&components.Context{}, manifestPath)
+	require.NoError(t, err)
+	return manifests
+}
@@ -0,0 +1,94 @@
+package application
+
+import (
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type getAppCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
+	applicationService applications.ApplicationService
+	applicationKey     string
+	format             string
+}
+
+func (gac *getAppCommand) Run() error {
+	ctx, err := service.NewContext(*gac.serverDetails, gac.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	descriptor, err := gac.applicationService.GetApplication(ctx, gac.applicationKey)
+	if err != nil {
+		return err
+	}
+	// The descriptor is printed in the same format accepted by 'app-create --spec'.
+	return output.Print(gac.format, descriptor)
+}
+
+func (gac *getAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return gac.serverDetails, nil
+}
+
+func (gac *getAppCommand) CommandName() string {
+	return commands.AppGet
+}
+
+func (gac *getAppCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	gac.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(gac.format); err != nil {
+		return err
+	}
+
+	gac.applicationKey = ctx.Arguments[0]
+
+	var err error
+	gac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	gac.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(gac)
+}
+
+func GetGetAppCommand(appContext app.Context) components.Command {
+	cmd := &getAppCommand{
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.AppGet,
+		Description: "Get the details of an application. The output can be used as a spec file for the app-create command.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"ag"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The key of the application to get.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.AppGet),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,131 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type listAppsCommand struct {
+	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
+	applicationService applications.ApplicationService
+	request            *model.ListApplicationsRequest
+	format             string
+}
+
+func (lac *listAppsCommand) Run() error {
+	ctx, err := service.NewContext(*lac.serverDetails, lac.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	return output.PrintItems(lac.format, lac.applicationService.ListApplications(ctx, lac.request))
+}
+
+func (lac *listAppsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return lac.serverDetails, nil
+}
+
+func (lac *listAppsCommand) CommandName() string {
+	return commands.AppList
+}
+
+func (lac *listAppsCommand) buildRequest(ctx *components.Context) (*model.ListApplicationsRequest, error) {
+	request := &model.ListApplicationsRequest{
+		ProjectKey: ctx.GetStringFlagValue(commands.ProjectFlag),
+		Owner:      ctx.GetStringFlagValue(commands.OwnerFlag),
+	}
+
+	if ctx.IsFlagSet(commands.LabelsFlag) {
+		labels, err := utils.ParseMapFlag(ctx.GetStringFlagValue(commands.LabelsFlag))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse --%s: %w", commands.LabelsFlag, err)
+		}
+		request.Labels = labels
+	}
+
+	maturityLevel, err := utils.ValidateEnumFlag(
+		commands.MaturityLevelFlag,
+		ctx.GetStringFlagValue(commands.MaturityLevelFlag),
+		"",
+		model.MaturityLevelValues)
+	if err != nil {
+		return nil, err
+	}
+	request.MaturityLevel = maturityLevel
+
+	businessCriticality, err := utils.ValidateEnumFlag(
+		commands.BusinessCriticalityFlag,
+		ctx.GetStringFlagValue(commands.BusinessCriticalityFlag),
+		"",
+		model.BusinessCriticalityValues)
+	if err != nil {
+		return nil, err
+	}
+	request.BusinessCriticality = businessCriticality
+
+	request.MaxItems, err = utils.ParseLimitFlag(commands.LimitFlag, ctx.GetStringFlagValue(commands.LimitFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (lac *listAppsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	lac.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(lac.format); err != nil {
+		return err
+	}
+
+	var err error
+	lac.request, err = lac.buildRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	lac.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	lac.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(lac)
+}
+
+func GetListAppsCommand(appContext app.Context) components.Command {
+	cmd := &listAppsCommand{
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.AppList,
+		Description: "List applications.",
+		Category:    common.CategoryApplication,
+		Aliases:     []string{"al"},
+		Arguments:   []components.Argument{},
+		Flags:       commands.GetCommandFlags(commands.AppList),
+		Action:      cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,159 @@
+package application
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/urfave/cli"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockapps "github.com/jfrog/jfrog-cli-application/apptrust/service/applications/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAppsCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+	request := &model.ListApplicationsRequest{ProjectKey: "proj"}
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().ListApplications(gomock.Any(), request).
+		Return(apphttp.Sequence([]model.AppDescriptor{{ApplicationKey: "app-1"}}, nil)).Times(1)
+
+	cmd := &listAppsCommand{
+		applicationService: mockAppService,
+		serverDetails:      serverDetails,
+		request:            request,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestListAppsCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+	request := &model.ListApplicationsRequest{}
+
+	mockAppService := mockapps.NewMockApplicationService(ctrl)
+	mockAppService.EXPECT().ListApplications(gomock.Any(), request).
+		Return(apphttp.Sequence[model.AppDescriptor](nil, errors.New("failed to list applications. Status code: 500"))).Times(1)
+
+	cmd := &listAppsCommand{
+		applicationService: mockAppService,
+		serverDetails:      serverDetails,
+		request:            request,
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "failed to list applications. Status code: 500")
+}
+
+func TestListAppsCommand_WrongNumberOfArguments(t *testing.T) {
+	app := cli.NewApp()
+	set := flag.NewFlagSet("test", 0)
+	ctx := cli.NewContext(app, set, nil)
+
+	cmd := &listAppsCommand{}
+
+	context, err := components.ConvertContext(ctx)
+	assert.NoError(t, err)
+	context.Arguments = []string{"unexpected"}
+
+	err = cmd.prepareAndRunCommand(context)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Wrong number of arguments")
+}
+
+func TestListAppsCommand_BuildRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctxSetup        func(*components.Context)
+		expectsError    bool
+		errorContains   string
+		expectedRequest *model.ListApplicationsRequest
+	}{
+		{
+			name:            "no filters",
+			ctxSetup:        func(ctx *components.Context) {},
+			expectedRequest: &model.ListApplicationsRequest{},
+		},
+		{
+			name: "all filters",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.ProjectFlag, "proj")
+				ctx.AddStringFlag(commands.LabelsFlag, "env=prod;team=core")
+				ctx.AddStringFlag(commands.OwnerFlag, "devops")
+				ctx.AddStringFlag(commands.MaturityLevelFlag, model.MaturityLevelExperimental)
+				ctx.AddStringFlag(commands.BusinessCriticalityFlag, model.BusinessCriticalityHigh)
+				ctx.AddStringFlag(commands.LimitFlag, "10")
+			},
+			expectedRequest: &model.ListApplicationsRequest{
+				ProjectKey:          "proj",
+				Labels:              map[string]string{"env": "prod", "team": "core"},
+				Owner:               "devops",
+				MaturityLevel:       model.MaturityLevelExperimental,
+				BusinessCriticality: model.BusinessCriticalityHigh,
+				MaxItems:            10,
+			},
+		},
+		{
+			name: "invalid maturity level",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.MaturityLevelFlag, "invalid")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --maturity-level",
+		},
+		{
+			name: "invalid business criticality",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.BusinessCriticalityFlag, "invalid")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --business-criticality",
+		},
+		{
+			name: "invalid labels",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.LabelsFlag, "env")
+			},
+			expectsError:  true,
+			errorContains: "failed to parse --labels",
+		},
+		{
+			name: "invalid limit",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.LimitFlag, "-5")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{}
+			tt.ctxSetup(ctx)
+
+			cmd := &listAppsCommand{}
+			request, err := cmd.buildRequest(ctx)
+			if tt.expectsError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRequest, request)
+		})
+	}
+}
@@ -1,33 +1,44 @@
 package application
 
 import (
+	"fmt"
+
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type updateAppCommand struct {
 	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
 	applicationService applications.ApplicationService
 	requestBody        *model.AppDescriptor
+	format             string
 }
 
 func (uac *updateAppCommand) Run() error {
-	ctx, err := service.NewContext(*uac.serverDetails)
+	ctx, err := service.NewContext(*uac.serverDetails, uac.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return uac.applicationService.UpdateApplication(ctx, uac.requestBody)
+	application, err := uac.applicationService.UpdateApplication(ctx, uac.requestBody)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Application \"%s\" updated successfully.", uac.requestBody.ApplicationKey))
+	return output.Print(uac.format, application)
 }
 
 func (uac *updateAppCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -58,6 +69,11 @@ func (uac *updateAppCommand) prepareAndRunCommand(ctx *components.Context) error
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	uac.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(uac.format); err != nil {
+		return err
+	}
+
 	var err error
 	uac.requestBody, err = uac.buildRequestPayload(ctx)
 	if err != nil {
@@ -68,8 +84,14 @@ func (uac *updateAppCommand) prepareAndRunCommand(ctx *components.Context) error
 	if err != nil {
 		return err
 	}
+	var release func()
+	uac.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 
-	return commonCLiCommands.Exec(uac)
+	return utils.ExecCommand(ctx, uac)
 }
 
 func GetUpdateAppCommand(appContext app.Context) components.Command {
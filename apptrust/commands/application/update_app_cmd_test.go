@@ -40,7 +40,7 @@ func TestUpdateAppCommand_Run(t *testing.T) {
 	}
 
 	mockAppService := mockapps.NewMockApplicationService(ctrl)
-	mockAppService.EXPECT().UpdateApplication(gomock.Any(), requestPayload).Return(nil).Times(1)
+	mockAppService.EXPECT().UpdateApplication(gomock.Any(), requestPayload).Return(nil, nil).Times(1)
 
 	cmd := &updateAppCommand{
 		applicationService: mockAppService,
@@ -76,7 +76,7 @@ func TestUpdateAppCommand_Run_Error(t *testing.T) {
 	}
 
 	mockAppService := mockapps.NewMockApplicationService(ctrl)
-	mockAppService.EXPECT().UpdateApplication(gomock.Any(), requestPayload).Return(errors.New("failed to update application. Status code: 500")).Times(1)
+	mockAppService.EXPECT().UpdateApplication(gomock.Any(), requestPayload).Return(nil, errors.New("failed to update application. Status code: 500")).Times(1)
 
 	cmd := &updateAppCommand{
 		applicationService: mockAppService,
@@ -338,9 +338,9 @@ func TestUpdateAppCommand_FlagsSuite(t *testing.T) {
 			mockAppService := mockapps.NewMockApplicationService(ctrl)
 			if !tt.expectsError {
 				mockAppService.EXPECT().UpdateApplication(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, req *model.AppDescriptor) error {
+					DoAndReturn(func(_ interface{}, req *model.AppDescriptor) (*model.AppDescriptor, error) {
 						actualPayload = req
-						return nil
+						return nil, nil
 					}).Times(1)
 			}
 
@@ -1,7 +1,12 @@
 package commands
 
 import (
+	"fmt"
+	"slices"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
@@ -18,17 +23,40 @@ const (
 	VersionUpdateSources = "version-update-sources"
 	PackageBind          = "package-bind"
 	PackageUnbind        = "package-unbind"
+	PackageList          = "package-list"
 	AppCreate            = "app-create"
 	AppUpdate            = "app-update"
 	AppDelete            = "app-delete"
+	AppList              = "app-list"
+	AppGet               = "app-get"
+	AppApply             = "app-apply"
+	AppExport            = "app-export"
+	VersionList          = "version-list"
+	VersionGet           = "version-get"
+	VersionWait          = "version-wait"
+	VersionHistory       = "version-history"
+	VersionDiff          = "version-diff"
+	Doctor               = "doctor"
+	SpecValidate         = "spec-validate"
 )
 
 const (
-	serverId    = "server-id"
-	url         = "url"
-	user        = "user"
-	accessToken = "access-token"
-	ProjectFlag = "project"
+	serverId        = "server-id"
+	url             = "url"
+	user            = "user"
+	AccessTokenFlag = "access-token"
+	ProjectFlag     = "project"
+
+	// Keys of flags that share their name with another flag but are documented differently for a specific command.
+	projectFilter = "project-filter"
+	stageFilter   = "stage-filter"
+	tagFilter     = "tag-filter"
+	draftFilter   = "draft-filter"
+	waitStage     = "wait-stage"
+	tableFormat   = "table-format"
+	doctorProject = "doctor-project"
+	exportProject = "export-project"
+	exportFile    = "export-file"
 
 	SpecFlag                          = "spec"
 	SpecVarsFlag                      = "spec-vars"
@@ -61,18 +89,71 @@ const (
 	DeletePropertiesFlag              = "delete-properties"
 	IncludeFilterFlag                 = "include-filter"
 	ExcludeFilterFlag                 = "exclude-filter"
+	OwnerFlag                         = "owner"
+	ReleaseStatusFlag                 = "release-status"
+	CreatedAfterFlag                  = "created-after"
+	CreatedBeforeFlag                 = "created-before"
+	SortByFlag                        = "sort-by"
+	SortOrderFlag                     = "sort-order"
+	ContentFlag                       = "content"
+	PackageTypeFlag                   = "package-type"
+	PackageNameFlag                   = "package-name"
+	WaitStateFlag                     = "state"
+	TimeoutFlag                       = "timeout"
+	IntervalFlag                      = "interval"
+	WaitTimeoutFlag                   = "wait-timeout"
+	FormatFlag                        = "format"
+	TargetApplicationFlag             = "target-application"
+	RetriesFlag                       = "retries"
+	RetryWaitFlag                     = "retry-wait"
+	TraceHttpFlag                     = "trace-http"
+	TraceHttpRedactFlag               = "trace-http-redact"
+	AccessTokenFileFlag               = "access-token-file"
+	CredentialHelperFlag              = "credential-helper"
+	OidcProviderFlag                  = "oidc-provider"
+	OidcTokenFileFlag                 = "oidc-token-file"
+	ApplicationFlag                   = "application"
+	PlanFlag                          = "plan"
+	SpecTypeFlag                      = "type"
+	FileFlag                          = "file"
+	AutoApproveFlag                   = "auto-approve"
+	PruneFlag                         = "prune"
+	IncludePackagesFlag               = "include-packages"
+	DirFlag                           = "dir"
+	LimitFlag                         = "limit"
+)
+
+// Environment variables that set the default value of flags shared by all commands.
+const (
+	RetriesEnv   = "JFROG_APPTRUST_RETRIES"
+	RetryWaitEnv = "JFROG_APPTRUST_RETRY_WAIT"
 )
 
+// Environment variables that provide the credentials of the commands when they are not set by flags.
+const (
+	AccessTokenEnv      = "JFROG_APPTRUST_ACCESS_TOKEN"
+	CredentialHelperEnv = "JFROG_APPTRUST_CREDENTIAL_HELPER"
+	OidcProviderEnv     = "JFROG_APPTRUST_OIDC_PROVIDER"
+	OidcTokenEnv        = "JFROG_APPTRUST_OIDC_TOKEN"
+)
+
+var formatFlagDescription = "The output format. The following values are supported: " + coreutils.ListToText(output.FormatValues) +
+	", or a Go template applied to the JSON output (e.g., '{{.version}}')."
+
+// tableFormatFlagDescription describes the --format flag of the commands whose output is a report meant to be read,
+// which defaults to table instead of json.
+var tableFormatFlagDescription = formatFlagDescription + " Unlike most commands, this command defaults to table."
+
 // Flag keys mapped to their corresponding components.Flag definition.
 var flagsMap = map[string]components.Flag{
 	// Common commands flags
-	serverId:    components.NewStringFlag(serverId, "Server ID configured using the config command.", func(f *components.StringFlag) { f.Mandatory = false }),
-	url:         components.NewStringFlag(url, "JFrog Platform URL.", func(f *components.StringFlag) { f.Mandatory = false }),
-	user:        components.NewStringFlag(user, "JFrog username.", func(f *components.StringFlag) { f.Mandatory = false }),
-	accessToken: components.NewStringFlag(accessToken, "JFrog access token.", func(f *components.StringFlag) { f.Mandatory = false }),
-	ProjectFlag: components.NewStringFlag(ProjectFlag, "Project key associated with the application. This flag is mandatory when the --spec flag is not provided.", func(f *components.StringFlag) { f.Mandatory = false }),
+	serverId:        components.NewStringFlag(serverId, "Server ID configured using the config command.", func(f *components.StringFlag) { f.Mandatory = false }),
+	url:             components.NewStringFlag(url, "JFrog Platform URL.", func(f *components.StringFlag) { f.Mandatory = false }),
+	user:            components.NewStringFlag(user, "JFrog username.", func(f *components.StringFlag) { f.Mandatory = false }),
+	AccessTokenFlag: components.NewStringFlag(AccessTokenFlag, "JFrog access token.", func(f *components.StringFlag) { f.Mandatory = false }),
+	ProjectFlag:     components.NewStringFlag(ProjectFlag, "Project key associated with the application. This flag is mandatory when the --spec flag is not provided.", func(f *components.StringFlag) { f.Mandatory = false }),
 
-	SpecFlag:                          components.NewStringFlag(SpecFlag, "A path to the specification file.", func(f *components.StringFlag) { f.Mandatory = false }),
+	SpecFlag:                          components.NewStringFlag(SpecFlag, "A path to the specification file, in JSON or YAML format.", func(f *components.StringFlag) { f.Mandatory = false }),
 	SpecVarsFlag:                      components.NewStringFlag(SpecVarsFlag, "List of semicolon-separated (;) variables in the form of \"key1=value1;key2=value2;...\" (wrapped by quotes) to be replaced in the File Spec. In the File Spec, the variables should be used as follows: ${key1}.", func(f *components.StringFlag) { f.Mandatory = false }),
 	StageVarsFlag:                     components.NewStringFlag(StageVarsFlag, "Promotion stage.", func(f *components.StringFlag) { f.Mandatory = true }),
 	ApplicationNameFlag:               components.NewStringFlag(ApplicationNameFlag, "The display name of the application.", func(f *components.StringFlag) { f.Mandatory = false }),
@@ -103,14 +184,94 @@ var flagsMap = map[string]components.Flag{
 	SourceTypeArtifactsFlag:           components.NewStringFlag(SourceTypeArtifactsFlag, "List of semicolon-separated (;) artifacts in the form of 'path=repo/path/to/artifact1[, sha256=hash1]; path=repo/path/to/artifact2[, sha256=hash2]' to be included in the new version.", func(f *components.StringFlag) { f.Mandatory = false }),
 	PropertiesFlag:                    components.NewStringFlag(PropertiesFlag, "Sets or updates custom properties for the application version in format 'key1=value1[,value2,...];key2=value3[,value4,...]'", func(f *components.StringFlag) { f.Mandatory = false }),
 	DeletePropertiesFlag:              components.NewStringFlag(DeletePropertiesFlag, "Remove a property key and all its values", func(f *components.StringFlag) { f.Mandatory = false }),
+	OwnerFlag:                         components.NewStringFlag(OwnerFlag, "Return only applications owned by the given user or group.", func(f *components.StringFlag) { f.Mandatory = false }),
+	ReleaseStatusFlag:                 components.NewStringFlag(ReleaseStatusFlag, "Return only versions with the given release status. The following values are supported: "+coreutils.ListToText(model.ReleaseStatusValues), func(f *components.StringFlag) { f.Mandatory = false }),
+	CreatedAfterFlag:                  components.NewStringFlag(CreatedAfterFlag, "Return only versions created at or after the given time, in the form of 'YYYY-MM-DD' or RFC 3339 (e.g. 2025-01-01T12:00:00Z).", func(f *components.StringFlag) { f.Mandatory = false }),
+	CreatedBeforeFlag:                 components.NewStringFlag(CreatedBeforeFlag, "Return only versions created at or before the given time, in the form of 'YYYY-MM-DD' or RFC 3339 (e.g. 2025-01-01T12:00:00Z).", func(f *components.StringFlag) { f.Mandatory = false }),
+	SortByFlag:                        components.NewStringFlag(SortByFlag, "The field to sort the versions by. By default, the versions are listed in the server order. Sorting by 'version' compares semantic versions, and fetches all the versions before listing them. The following values are supported: "+coreutils.ListToText(model.VersionSortByValues), func(f *components.StringFlag) { f.Mandatory = false }),
+	SortOrderFlag:                     components.NewStringFlag(SortOrderFlag, "The sort order, used with --sort-by. The following values are supported: "+coreutils.ListToText(model.SortOrderValues), func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.SortOrderDesc }),
+	ContentFlag:                       components.NewBoolFlag(ContentFlag, "Whether to include the full list of artifacts of each releasable.", components.WithBoolDefaultValueTrue()),
+	PackageTypeFlag:                   components.NewStringFlag(PackageTypeFlag, "Return only packages of the given type (e.g., npm, docker, maven, generic).", func(f *components.StringFlag) { f.Mandatory = false }),
+	PackageNameFlag:                   components.NewStringFlag(PackageNameFlag, "Return only packages whose name matches the given pattern. The pattern may include wildcards (e.g., 'frontend-*').", func(f *components.StringFlag) { f.Mandatory = false }),
+	WaitStateFlag:                     components.NewStringFlag(WaitStateFlag, "The state to wait for. The 'promoted' and 'failed' states are decided by the latest promotion of the version, to --stage when it is set. The following values are supported: "+coreutils.ListToText(model.WaitStateValues), func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = model.WaitStateCreated }),
+	TimeoutFlag:                       components.NewStringFlag(TimeoutFlag, "The maximum time for the command to run, as a duration (e.g., 30s, 5m, 1h). When the time elapses, or when the command is interrupted with Ctrl-C, the request in progress is canceled. By default, there is no time limit.", func(f *components.StringFlag) { f.Mandatory = false }),
+	WaitTimeoutFlag:                   components.NewStringFlag(WaitTimeoutFlag, "The maximum time to wait, as a duration (e.g., 30s, 5m, 1h). When the time elapses, the command fails with the status of the version. Interrupting the command with Ctrl-C stops waiting.", func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = "10m" }),
+	IntervalFlag:                      components.NewStringFlag(IntervalFlag, "The initial time between status checks, as a duration (e.g., 2s, 10s). The interval doubles after each check, up to 30 seconds.", func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = "5s" }),
+	FormatFlag:                        components.NewStringFlag(FormatFlag, formatFlagDescription, func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = output.FormatJson }),
+	LimitFlag:                         components.NewStringFlag(LimitFlag, "The maximum number of items to return. By default, all the items are returned.", func(f *components.StringFlag) { f.Mandatory = false }),
+	TargetApplicationFlag:             components.NewStringFlag(TargetApplicationFlag, "The application key of <version-b>, to compare versions of two different applications. Defaults to <application-key>.", func(f *components.StringFlag) { f.Mandatory = false }),
+	RetriesFlag:                       components.NewStringFlag(RetriesFlag, fmt.Sprintf("The number of times to retry a request that failed with a transient error, such as a rate limit. Requests that create or promote resources are retried only when the server did not process them. Can also be set with the %s environment variable. Default: %d.", RetriesEnv, apphttp.DefaultRetries), func(f *components.StringFlag) { f.Mandatory = false }),
+	TraceHttpFlag:                     components.NewStringFlag(TraceHttpFlag, "A path to a file in which to record every HTTP request and response, for troubleshooting. Files with the .har extension are written in the HAR format, and other files as JSON Lines. Authorization headers, tokens, passwords and other secrets are redacted.", func(f *components.StringFlag) { f.Mandatory = false }),
+	TraceHttpRedactFlag:               components.NewStringFlag(TraceHttpRedactFlag, "Semicolon-separated list of additional field, header and property names whose values are redacted in the --trace-http file. Names are matched case-insensitively, as substrings.", func(f *components.StringFlag) { f.Mandatory = false }),
+	RetryWaitFlag:                     components.NewStringFlag(RetryWaitFlag, fmt.Sprintf("The wait before the first retry, as a duration (e.g., 500ms, 2s). The wait doubles after each retry, unless the server sets a Retry-After header. Can also be set with the %s environment variable. Default: %s.", RetryWaitEnv, apphttp.DefaultRetryWait), func(f *components.StringFlag) { f.Mandatory = false }),
+	AccessTokenFileFlag:               components.NewStringFlag(AccessTokenFileFlag, fmt.Sprintf("A path to a file that contains the JFrog access token. Credentials are resolved in the following order: --access-token, --access-token-file, --oidc-provider, the %s environment variable, the credential helper, and the configured server.", AccessTokenEnv), func(f *components.StringFlag) { f.Mandatory = false }),
+	CredentialHelperFlag:              components.NewStringFlag(CredentialHelperFlag, fmt.Sprintf("The name or path of an executable that provides the JFrog access token. It is run with the 'get' argument, receives {\"ServerURL\": \"<url>\"} on stdin, and writes {\"Secret\": \"<token>\"} to stdout. Can also be set with the %s environment variable.", CredentialHelperEnv), func(f *components.StringFlag) { f.Mandatory = false }),
+	OidcProviderFlag:                  components.NewStringFlag(OidcProviderFlag, fmt.Sprintf("The name of the OIDC integration of the JFrog Platform with which to exchange the ID token of the CI job for a short-lived access token. The ID token is read from --oidc-token-file, or from the %s environment variable. Can also be set with the %s environment variable.", OidcTokenEnv, OidcProviderEnv), func(f *components.StringFlag) { f.Mandatory = false }),
+	OidcTokenFileFlag:                 components.NewStringFlag(OidcTokenFileFlag, "A path to a file that contains the ID token of the CI job, for --oidc-provider.", func(f *components.StringFlag) { f.Mandatory = false }),
+	ApplicationFlag:                   components.NewStringFlag(ApplicationFlag, "The key of an application to check read access to.", func(f *components.StringFlag) { f.Mandatory = false }),
+	PlanFlag:                          components.NewBoolFlag(PlanFlag, "Print the HTTP request that changes resources, with its method, endpoint, query parameters and payload, instead of sending it. Read-only GET requests are still sent.", components.WithBoolDefaultValueFalse()),
+	SpecTypeFlag:                      components.NewStringFlag(SpecTypeFlag, "The type of the spec file. The following values are supported: app (the spec of app-create), version (the spec of version-create and version-update-sources) and manifest (the manifests of app-apply).", func(f *components.StringFlag) { f.Mandatory = true }),
+	FileFlag:                          components.NewStringFlag(FileFlag, "A path to a manifest file, in JSON or YAML format, or to a directory of manifest files. A YAML file may hold several manifests, separated by '---' lines.", func(f *components.StringFlag) { f.Mandatory = true }),
+	AutoApproveFlag:                   components.NewBoolFlag(AutoApproveFlag, "Apply the plan. By default, the plan is only printed.", components.WithBoolDefaultValueFalse()),
+	PruneFlag:                         components.NewBoolFlag(PruneFlag, "Also delete the applications of the projects of the manifests that no manifest declares, and unbind the packages that the manifests don't declare.", components.WithBoolDefaultValueFalse()),
+	IncludePackagesFlag:               components.NewBoolFlag(IncludePackagesFlag, "Include the packages bound to each application, with their latest bound version.", components.WithBoolDefaultValueFalse()),
+	DirFlag:                           components.NewStringFlag(DirFlag, "A path to a directory to write one spec file to for each application, named after the application key. The directory is created if it doesn't exist.", func(f *components.StringFlag) { f.Mandatory = false }),
+
+	// Command-specific variants of shared flags
+	projectFilter: components.NewStringFlag(ProjectFlag, "Return only applications that belong to the given project key.", func(f *components.StringFlag) { f.Mandatory = false }),
+	stageFilter:   components.NewStringFlag(StageVarsFlag, "Return only versions whose current stage is the given stage.", func(f *components.StringFlag) { f.Mandatory = false }),
+	tagFilter:     components.NewStringFlag(TagFlag, "Return only versions with the given tag.", func(f *components.StringFlag) { f.Mandatory = false }),
+	draftFilter:   components.NewBoolFlag(DraftFlag, "Set to true to return only draft versions, or to false to return only non-draft versions.", components.WithBoolDefaultValueFalse()),
+	tableFormat:   components.NewStringFlag(FormatFlag, tableFormatFlagDescription, func(f *components.StringFlag) { f.Mandatory = false; f.DefaultValue = output.FormatTable }),
+	waitStage:     components.NewStringFlag(StageVarsFlag, "The stage the version should be promoted to. Mandatory when --state is 'promoted'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	doctorProject: components.NewStringFlag(ProjectFlag, "The key of a project to check read access to.", func(f *components.StringFlag) { f.Mandatory = false }),
+	exportProject: components.NewStringFlag(ProjectFlag, "Export all the applications of the given project, instead of a single application.", func(f *components.StringFlag) { f.Mandatory = false }),
+	exportFile:    components.NewStringFlag(FileFlag, "A path to a file to write the spec of all the applications to, as one YAML document for each application. By default, the specs are printed.", func(f *components.StringFlag) { f.Mandatory = false }),
 }
 
+// serverFlags are the flags of every command that sends requests to the server: the connection details,
+// the credentials, and the retries, tracing and timeout of the HTTP client.
+var serverFlags = []string{
+	url,
+	user,
+	AccessTokenFlag,
+	serverId,
+	AccessTokenFileFlag,
+	CredentialHelperFlag,
+	OidcProviderFlag,
+	OidcTokenFileFlag,
+	RetriesFlag,
+	RetryWaitFlag,
+	TraceHttpFlag,
+	TraceHttpRedactFlag,
+	TimeoutFlag,
+}
+
+// offlineCommands don't send requests to the server, and have no serverFlags.
+var offlineCommands = map[string]bool{
+	SpecValidate: true,
+}
+
+// mutatingCommands change the state of the server, and print their request instead of sending it with --plan.
+var mutatingCommands = map[string]bool{
+	VersionCreate:        true,
+	VersionPromote:       true,
+	VersionRelease:       true,
+	VersionDelete:        true,
+	VersionRollback:      true,
+	VersionUpdate:        true,
+	VersionUpdateSources: true,
+	PackageBind:          true,
+	PackageUnbind:        true,
+	AppCreate:            true,
+	AppUpdate:            true,
+	AppDelete:            true,
+}
+
+// commandFlags holds the flags of each command, other than the serverFlags and the --plan flag, which
+// GetCommandFlags adds.
 var commandFlags = map[string][]string{
 	VersionCreate: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		SyncFlag,
 		TagFlag,
 		DraftFlag,
@@ -124,12 +285,9 @@ var commandFlags = map[string][]string{
 		ExcludeFilterFlag,
 		SpecVarsFlag,
 		DryRunFlag,
+		FormatFlag,
 	},
 	VersionPromote: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		SyncFlag,
 		PromotionTypeFlag,
 		DryRunFlag,
@@ -137,46 +295,28 @@ var commandFlags = map[string][]string{
 		IncludeReposFlag,
 		PropsFlag,
 		OverwriteStrategyFlag,
+		FormatFlag,
 	},
 	VersionRelease: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		SyncFlag,
 		PromotionTypeFlag,
 		ExcludeReposFlag,
 		IncludeReposFlag,
 		PropsFlag,
 		OverwriteStrategyFlag,
+		FormatFlag,
 	},
-	VersionDelete: {
-		url,
-		user,
-		accessToken,
-		serverId,
-	},
+	VersionDelete: {},
 	VersionRollback: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		SyncFlag,
+		FormatFlag,
 	},
 	VersionUpdate: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		TagFlag,
 		PropertiesFlag,
 		DeletePropertiesFlag,
 	},
 	VersionUpdateSources: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		SyncFlag,
 		DryRunFlag,
 		FailFastFlag,
@@ -189,33 +329,29 @@ var commandFlags = map[string][]string{
 		SpecVarsFlag,
 		IncludeFilterFlag,
 		ExcludeFilterFlag,
+		FormatFlag,
 	},
 
 	PackageBind: {
-		url,
-		user,
-		accessToken,
-		serverId,
+		FormatFlag,
 	},
-	PackageUnbind: {
-		url,
-		user,
-		accessToken,
-		serverId,
+	PackageUnbind: {},
+	PackageList: {
+		PackageTypeFlag,
+		PackageNameFlag,
+		LimitFlag,
+		FormatFlag,
 	},
 
-	Ping: {
-		url,
-		user,
-		accessToken,
-		serverId,
+	Ping: {},
+
+	Doctor: {
+		doctorProject,
+		ApplicationFlag,
+		tableFormat,
 	},
 
 	AppCreate: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		ApplicationNameFlag,
 		ProjectFlag,
 		DescriptionFlag,
@@ -226,13 +362,10 @@ var commandFlags = map[string][]string{
 		GroupOwnersFlag,
 		SpecFlag,
 		SpecVarsFlag,
+		FormatFlag,
 	},
 
 	AppUpdate: {
-		url,
-		user,
-		accessToken,
-		serverId,
 		ApplicationNameFlag,
 		DescriptionFlag,
 		BusinessCriticalityFlag,
@@ -242,16 +375,92 @@ var commandFlags = map[string][]string{
 		RemoveLabelsFlag,
 		UserOwnersFlag,
 		GroupOwnersFlag,
+		FormatFlag,
 	},
 
-	AppDelete: {
-		url,
-		user,
-		accessToken,
-		serverId,
+	AppDelete: {},
+
+	AppList: {
+		projectFilter,
+		LabelsFlag,
+		OwnerFlag,
+		MaturityLevelFlag,
+		BusinessCriticalityFlag,
+		LimitFlag,
+		FormatFlag,
+	},
+
+	AppGet: {
+		FormatFlag,
+	},
+
+	VersionList: {
+		stageFilter,
+		tagFilter,
+		ReleaseStatusFlag,
+		draftFilter,
+		CreatedAfterFlag,
+		CreatedBeforeFlag,
+		SortByFlag,
+		SortOrderFlag,
+		LimitFlag,
+		FormatFlag,
+	},
+
+	VersionGet: {
+		ContentFlag,
+		FormatFlag,
+	},
+
+	VersionWait: {
+		WaitStateFlag,
+		waitStage,
+		WaitTimeoutFlag,
+		IntervalFlag,
+		FormatFlag,
+	},
+
+	VersionHistory: {
+		tableFormat,
+	},
+
+	VersionDiff: {
+		TargetApplicationFlag,
+		tableFormat,
+	},
+
+	AppApply: {
+		FileFlag,
+		SpecVarsFlag,
+		AutoApproveFlag,
+		PruneFlag,
+		tableFormat,
+	},
+
+	AppExport: {
+		exportProject,
+		IncludePackagesFlag,
+		DirFlag,
+		exportFile,
+	},
+
+	SpecValidate: {
+		SpecTypeFlag,
+		SpecVarsFlag,
+		tableFormat,
 	},
 }
 
 func GetCommandFlags(cmdKey string) []components.Flag {
-	return pluginsCommon.GetCommandFlags(cmdKey, commandFlags, flagsMap)
+	if _, ok := commandFlags[cmdKey]; !ok {
+		return pluginsCommon.GetCommandFlags(cmdKey, commandFlags, flagsMap)
+	}
+	flagKeys := slices.Clone(commandFlags[cmdKey])
+	if !offlineCommands[cmdKey] {
+		flagKeys = append(flagKeys, serverFlags...)
+	}
+	if mutatingCommands[cmdKey] {
+		flagKeys = append(flagKeys, PlanFlag)
+	}
+	return pluginsCommon.GetCommandFlags(cmdKey, map[string][]string{cmdKey: flagKeys}, flagsMap)
 }
@@ -5,28 +5,37 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type bindPackageCommand struct {
 	packageService packages.PackageService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	requestPayload *model.BindPackageRequest
+	format         string
 }
 
 func (bp *bindPackageCommand) Run() error {
-	ctx, err := service.NewContext(*bp.serverDetails)
+	ctx, err := service.NewContext(*bp.serverDetails, bp.clientOptions...)
 	if err != nil {
 		return err
 	}
-	return bp.packageService.BindPackage(ctx, bp.applicationKey, bp.requestPayload)
+	binding, err := bp.packageService.BindPackage(ctx, bp.applicationKey, bp.requestPayload)
+	if err != nil {
+		return err
+	}
+	log.Info("Package bound successfully.")
+	return output.Print(bp.format, binding)
 }
 
 func (bp *bindPackageCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -42,14 +51,25 @@ func (bp *bindPackageCommand) prepareAndRunCommand(ctx *components.Context) erro
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	bp.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(bp.format); err != nil {
+		return err
+	}
+
 	var err error
 	bp.serverDetails, err = utils.ServerDetailsByFlags(ctx)
 	if err != nil {
 		return err
 	}
+	var release func()
+	bp.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 	bp.extractFromArgs(ctx)
 
-	return commonCLiCommands.Exec(bp)
+	return utils.ExecCommand(ctx, bp)
 }
 
 func (bp *bindPackageCommand) extractFromArgs(ctx *components.Context) {
@@ -25,7 +25,7 @@ func TestBindPackageCommand_Run(t *testing.T) {
 
 	mockPackageService := mockpackages.NewMockPackageService(ctrl)
 	mockPackageService.EXPECT().BindPackage(gomock.Any(), applicationKey, requestPayload).
-		Return(nil).Times(1)
+		Return(nil, nil).Times(1)
 
 	cmd := &bindPackageCommand{
 		packageService: mockPackageService,
@@ -52,7 +52,7 @@ func TestBindPackageCommand_Run_Error(t *testing.T) {
 
 	mockPackageService := mockpackages.NewMockPackageService(ctrl)
 	mockPackageService.EXPECT().BindPackage(gomock.Any(), applicationKey, requestPayload).
-		Return(errors.New("bind error")).Times(1)
+		Return(nil, errors.New("bind error")).Times(1)
 
 	cmd := &bindPackageCommand{
 		packageService: mockPackageService,
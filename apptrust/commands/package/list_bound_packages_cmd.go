@@ -0,0 +1,96 @@
+package packagecmds
+
+import (
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type listBoundPackagesCommand struct {
+	packageService packages.PackageService
+	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
+	applicationKey string
+	request        *model.ListBoundPackagesRequest
+	format         string
+}
+
+func (lp *listBoundPackagesCommand) Run() error {
+	ctx, err := service.NewContext(*lp.serverDetails, lp.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	return output.PrintItems(lp.format, lp.packageService.ListBoundPackages(ctx, lp.applicationKey, lp.request))
+}
+
+func (lp *listBoundPackagesCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return lp.serverDetails, nil
+}
+
+func (lp *listBoundPackagesCommand) CommandName() string {
+	return commands.PackageList
+}
+
+func (lp *listBoundPackagesCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	lp.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(lp.format); err != nil {
+		return err
+	}
+
+	maxItems, err := utils.ParseLimitFlag(commands.LimitFlag, ctx.GetStringFlagValue(commands.LimitFlag))
+	if err != nil {
+		return err
+	}
+
+	lp.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	lp.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	lp.applicationKey = ctx.Arguments[0]
+	lp.request = &model.ListBoundPackagesRequest{
+		Type:        ctx.GetStringFlagValue(commands.PackageTypeFlag),
+		NamePattern: ctx.GetStringFlagValue(commands.PackageNameFlag),
+		MaxItems:    maxItems,
+	}
+
+	return commonCLiCommands.Exec(lp)
+}
+
+func GetListBoundPackagesCommand(appContext app.Context) components.Command {
+	cmd := &listBoundPackagesCommand{packageService: appContext.GetPackageService()}
+	return components.Command{
+		Name:        commands.PackageList,
+		Description: "List the packages bound to an application.",
+		Category:    common.CategoryPackage,
+		Aliases:     []string{"pl"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The key of the application whose bound packages to list.",
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.PackageList),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
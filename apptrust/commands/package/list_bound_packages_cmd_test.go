@@ -0,0 +1,58 @@
+package packagecmds
+
+import (
+	"errors"
+	"testing"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockpackages "github.com/jfrog/jfrog-cli-application/apptrust/service/packages/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListBoundPackagesCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+	request := &model.ListBoundPackagesRequest{Type: "npm", NamePattern: "frontend-*"}
+
+	mockPackageService := mockpackages.NewMockPackageService(ctrl)
+	mockPackageService.EXPECT().ListBoundPackages(gomock.Any(), "app-key", request).
+		Return(apphttp.Sequence([]model.PackageBinding{{Type: "npm", Name: "frontend-ui"}}, nil)).Times(1)
+
+	cmd := &listBoundPackagesCommand{
+		packageService: mockPackageService,
+		serverDetails:  serverDetails,
+		applicationKey: "app-key",
+		request:        request,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestListBoundPackagesCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+	request := &model.ListBoundPackagesRequest{}
+
+	mockPackageService := mockpackages.NewMockPackageService(ctrl)
+	mockPackageService.EXPECT().ListBoundPackages(gomock.Any(), "app-key", request).
+		Return(apphttp.Sequence[model.PackageBinding](nil, errors.New("list error"))).Times(1)
+
+	cmd := &listBoundPackagesCommand{
+		packageService: mockPackageService,
+		serverDetails:  serverDetails,
+		applicationKey: "app-key",
+		request:        request,
+	}
+
+	err := cmd.Run()
+	assert.Error(t, err)
+	assert.Equal(t, "list error", err.Error())
+}
@@ -5,17 +5,19 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type unbindPackageCommand struct {
 	packageService packages.PackageService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	packageType    string
 	packageName    string
@@ -23,11 +25,15 @@ type unbindPackageCommand struct {
 }
 
 func (up *unbindPackageCommand) Run() error {
-	ctx, err := service.NewContext(*up.serverDetails)
+	ctx, err := service.NewContext(*up.serverDetails, up.clientOptions...)
 	if err != nil {
 		return err
 	}
-	return up.packageService.UnbindPackage(ctx, up.applicationKey, up.packageType, up.packageName, up.packageVersion)
+	if err = up.packageService.UnbindPackage(ctx, up.applicationKey, up.packageType, up.packageName, up.packageVersion); err != nil {
+		return err
+	}
+	log.Info("Package unbound successfully.")
+	return nil
 }
 
 func (up *unbindPackageCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -48,6 +54,12 @@ func (up *unbindPackageCommand) prepareAndRunCommand(ctx *components.Context) er
 	if err != nil {
 		return err
 	}
+	var release func()
+	up.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	// Extract from arguments
 	up.applicationKey = ctx.Arguments[0]
@@ -55,7 +67,7 @@ func (up *unbindPackageCommand) prepareAndRunCommand(ctx *components.Context) er
 	up.packageName = ctx.Arguments[2]
 	up.packageVersion = ctx.Arguments[3]
 
-	return commonCLiCommands.Exec(up)
+	return utils.ExecCommand(ctx, up)
 }
 
 func GetUnbindPackageCommand(appContext app.Context) components.Command {
@@ -0,0 +1,16 @@
+//go:build ignore
+
+// generate_schemas writes the JSON Schemas of the spec files to the schemas directory of the repository.
+package main
+
+import (
+	"log"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/spec"
+)
+
+func main() {
+	if err := spec.WriteSchemas("../../../schemas"); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,304 @@
+package spec
+
+//go:generate go run generate_schemas.go
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	SpecTypeApp      = "app"
+	SpecTypeVersion  = "version"
+	SpecTypeManifest = "manifest"
+
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+)
+
+var SpecTypeValues = []string{
+	SpecTypeApp,
+	SpecTypeVersion,
+	SpecTypeManifest,
+}
+
+// Schema is a JSON Schema, with the keywords that the schemas of the spec files use.
+type Schema struct {
+	Dialect     string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+	// Properties are the fields of an object.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	// AdditionalProperties is false for objects that accept only their Properties, or the *Schema of the
+	// values of a map.
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+	Required             []string    `json:"required,omitempty"`
+	MinProperties        int         `json:"minProperties,omitempty"`
+	Items                *Schema     `json:"items,omitempty"`
+	MinItems             int         `json:"minItems,omitempty"`
+	Enum                 []string    `json:"enum,omitempty"`
+	AnyOf                []*Schema   `json:"anyOf,omitempty"`
+	OneOf                []*Schema   `json:"oneOf,omitempty"`
+}
+
+// schemaRules complete the schema of a struct with what its Go type doesn't tell.
+type schemaRules struct {
+	descriptions map[string]string
+	required     []string
+	enums        map[string][]string
+	// exclude are the fields that aren't part of the spec.
+	exclude []string
+	// atLeastOneOf are list fields of which at least one must have an item.
+	atLeastOneOf []string
+	// variants split the fields into objects that are mutually exclusive, such as package and artifact filters.
+	variants []schemaVariant
+}
+
+type schemaVariant struct {
+	title  string
+	fields []string
+}
+
+var rulesByType = map[reflect.Type]schemaRules{
+	reflect.TypeOf(model.AppDescriptor{}): {
+		descriptions: map[string]string{
+			"application_key":  "Ignored. The application key is the argument of app-create.",
+			"application_name": "The display name of the application. Defaults to the application key.",
+			"project_key":      "The key of the project of the application.",
+			"description":      "The description of the application.",
+			"maturity_level":   "The maturity level of the application.",
+			"criticality":      "The business criticality of the application.",
+			"labels":           "The labels of the application, as key-value pairs.",
+			"user_owners":      "The names of the users that own the application.",
+			"group_owners":     "The names of the groups that own the application.",
+		},
+		required: []string{"project_key"},
+		enums: map[string][]string{
+			"maturity_level": model.MaturityLevelValues,
+			"criticality":    model.BusinessCriticalityValues,
+		},
+		exclude: []string{"label_updates"},
+	},
+	reflect.TypeOf(model.AppManifest{}): {
+		descriptions: map[string]string{
+			"application_key": "The key of the application.",
+			"packages":        "The package versions that are bound to the application. Other bound packages are unbound with --prune.",
+		},
+		required: []string{"application_key", "project_key"},
+	},
+	reflect.TypeOf(model.ManifestPackage{}): {
+		descriptions: map[string]string{
+			"type":    "The type of the package, e.g., npm or docker.",
+			"name":    "The name of the package.",
+			"version": "The version of the package.",
+		},
+		required: []string{"type", "name", "version"},
+	},
+	reflect.TypeOf(model.VersionSpec{}): {
+		descriptions: map[string]string{
+			"artifacts":       "The artifacts of the version.",
+			"packages":        "The packages of the version.",
+			"builds":          "The builds whose artifacts are included in the version.",
+			"release_bundles": "The release bundles whose artifacts are included in the version.",
+			"versions":        "The versions of other applications whose contents are included in the version.",
+			"filters":         "The filters of the packages and artifacts of the sources.",
+		},
+		atLeastOneOf: []string{"artifacts", "packages", "builds", "release_bundles", "versions"},
+	},
+	reflect.TypeOf(model.CreateVersionArtifact{}): {
+		descriptions: map[string]string{
+			"path":   "The path of the artifact, starting with its repository key.",
+			"sha256": "The SHA-256 checksum of the artifact.",
+		},
+		required: []string{"path"},
+	},
+	reflect.TypeOf(model.CreateVersionPackage{}): {
+		descriptions: map[string]string{
+			"type":           "The type of the package, e.g., npm or docker.",
+			"name":           "The name of the package.",
+			"version":        "The version of the package.",
+			"repository_key": "The key of the repository of the package.",
+		},
+		required: []string{"type", "name", "version", "repository_key"},
+	},
+	reflect.TypeOf(model.CreateVersionBuild{}): {
+		descriptions: map[string]string{
+			"repository_key":       "The key of the build-info repository of the build.",
+			"name":                 "The name of the build.",
+			"number":               "The number of the build.",
+			"started":              "The start time of the build, to select one of the builds with the same name and number.",
+			"include_dependencies": "Whether to include the dependencies of the build.",
+		},
+		required: []string{"name", "number"},
+	},
+	reflect.TypeOf(model.CreateVersionReleaseBundle{}): {
+		descriptions: map[string]string{
+			"project_key":    "The key of the project of the release bundle.",
+			"repository_key": "The key of the repository of the release bundle.",
+			"name":           "The name of the release bundle.",
+			"version":        "The version of the release bundle.",
+		},
+		required: []string{"name", "version"},
+	},
+	reflect.TypeOf(model.CreateVersionReference{}): {
+		descriptions: map[string]string{
+			"application_key": "The key of the application of the version.",
+			"version":         "The version.",
+		},
+		required: []string{"application_key", "version"},
+	},
+	reflect.TypeOf(model.CreateVersionFilters{}): {
+		descriptions: map[string]string{
+			"included": "The filters of the packages and artifacts to include.",
+			"excluded": "The filters of the packages and artifacts to exclude.",
+		},
+	},
+	reflect.TypeOf(model.CreateVersionSourceFilter{}): {
+		descriptions: map[string]string{
+			"package_type":    "The type of the packages.",
+			"package_name":    "The name of the packages.",
+			"package_version": "The version of the packages.",
+			"path":            "The path of the artifacts.",
+			"sha256":          "The SHA-256 checksum of the artifacts.",
+		},
+		variants: []schemaVariant{
+			{title: model.FilterTypePackage + " filter", fields: []string{"package_type", "package_name", "package_version"}},
+			{title: model.FilterTypeArtifact + " filter", fields: []string{"path", "sha256"}},
+		},
+	},
+}
+
+// SchemaOf returns the JSON Schema of the spec files of specType, one of SpecTypeValues.
+func SchemaOf(specType string) (*Schema, error) {
+	var schema *Schema
+	switch specType {
+	case SpecTypeApp:
+		schema = schemaOfType(reflect.TypeOf(model.AppDescriptor{}))
+		schema.Title = "AppTrust application spec"
+		schema.Description = "The spec file of the --spec flag of the app-create command."
+	case SpecTypeVersion:
+		schema = schemaOfType(reflect.TypeOf(model.VersionSpec{}))
+		schema.Title = "AppTrust version spec"
+		schema.Description = "The spec file of the --spec flag of the version-create and version-update-sources commands."
+	case SpecTypeManifest:
+		schema = schemaOfType(reflect.TypeOf(model.AppManifest{}))
+		schema.Title = "AppTrust application manifest"
+		schema.Description = "A manifest of the app-apply command. A YAML manifest file holds one manifest in each of its documents."
+	default:
+		return nil, errorutils.CheckErrorf("invalid spec type '%s'", specType)
+	}
+	schema.Dialect = jsonSchemaDialect
+	return schema, nil
+}
+
+// WriteSchemas writes the JSON Schema of each spec type to dir, in the <type>-spec.schema.json file.
+func WriteSchemas(dir string) error {
+	for _, specType := range SpecTypeValues {
+		schema, err := SchemaOf(specType)
+		if err != nil {
+			return err
+		}
+		content, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		content = append(content, '\n')
+		if err = os.WriteFile(filepath.Join(dir, specType+"-spec.schema.json"), content, 0644); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return nil
+}
+
+func schemaOfType(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaOfType(t.Elem())
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: schemaOfType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: schemaOfType(t.Elem())}
+	case reflect.Struct:
+		return schemaOfStruct(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func schemaOfStruct(t reflect.Type) *Schema {
+	rules := rulesByType[t]
+	properties := map[string]*Schema{}
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.Anonymous && field.Tag.Get("json") == "" && field.Type.Kind() == reflect.Struct {
+			// The fields of an embedded struct are fields of the struct, with the rules of the embedded struct.
+			for name, property := range schemaOfStruct(field.Type).Properties {
+				if description, ok := rules.descriptions[name]; ok {
+					property.Description = description
+				}
+				properties[name] = property
+			}
+			continue
+		}
+		name := jsonFieldName(t.Field(i))
+		if name == "" || slices.Contains(rules.exclude, name) {
+			continue
+		}
+		property := schemaOfType(t.Field(i).Type)
+		property.Description = rules.descriptions[name]
+		property.Enum = rules.enums[name]
+		properties[name] = property
+	}
+
+	schema := &Schema{Type: "object"}
+	if len(rules.variants) == 0 {
+		schema.Properties = properties
+		schema.AdditionalProperties = false
+		schema.Required = rules.required
+	}
+	for _, variant := range rules.variants {
+		variantSchema := &Schema{Title: variant.title, Type: "object", Properties: map[string]*Schema{}, AdditionalProperties: false, MinProperties: 1}
+		for _, field := range variant.fields {
+			variantSchema.Properties[field] = properties[field]
+		}
+		schema.OneOf = append(schema.OneOf, variantSchema)
+	}
+	for _, field := range rules.atLeastOneOf {
+		schema.AnyOf = append(schema.AnyOf, &Schema{
+			Properties: map[string]*Schema{field: {MinItems: 1}},
+			Required:   []string{field},
+		})
+	}
+	return schema
+}
+
+// jsonFieldName returns the name of the field in JSON, or an empty string if the field isn't encoded.
+func jsonFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
@@ -0,0 +1,60 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSchemasUpToDate fails when the published schemas don't match the spec types. Run 'go generate' in this
+// package to update them.
+func TestSchemasUpToDate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, WriteSchemas(dir))
+
+	for _, specType := range SpecTypeValues {
+		name := specType + "-spec.schema.json"
+		expected, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		actual, err := os.ReadFile(filepath.Join("..", "..", "..", "schemas", name))
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(actual), "%s is outdated", name)
+	}
+}
+
+func TestSchemaOf(t *testing.T) {
+	schema, err := SchemaOf(SpecTypeApp)
+	require.NoError(t, err)
+	assert.Equal(t, jsonSchemaDialect, schema.Dialect)
+	assert.Equal(t, []string{"project_key"}, schema.Required)
+	assert.Equal(t, false, schema.AdditionalProperties)
+	assert.NotContains(t, schema.Properties, "label_updates")
+	assert.Equal(t, "string", schema.Properties["maturity_level"].Type)
+	assert.Equal(t, &Schema{Type: "string"}, schema.Properties["labels"].AdditionalProperties)
+	assert.Equal(t, &Schema{Type: "string"}, schema.Properties["user_owners"].Items)
+
+	schema, err = SchemaOf(SpecTypeVersion)
+	require.NoError(t, err)
+	assert.Len(t, schema.AnyOf, 5)
+	filter := schema.Properties["filters"].Properties["included"].Items
+	require.Len(t, filter.OneOf, 2)
+	assert.Equal(t, "package filter", filter.OneOf[0].Title)
+	assert.Equal(t, "artifact filter", filter.OneOf[1].Title)
+
+	// The fields of the embedded descriptor are fields of the manifest.
+	schema, err = SchemaOf(SpecTypeManifest)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"application_key", "project_key"}, schema.Required)
+	assert.Equal(t, "The key of the application.", schema.Properties["application_key"].Description)
+	assert.Equal(t, model.MaturityLevelValues, schema.Properties["maturity_level"].Enum)
+	assert.NotContains(t, schema.Properties, "label_updates")
+	assert.NotContains(t, schema.Properties, "AppDescriptor")
+	assert.Equal(t, []string{"type", "name", "version"}, schema.Properties["packages"].Items.Required)
+
+	_, err = SchemaOf("build")
+	assert.EqualError(t, err, "invalid spec type 'build'")
+}
@@ -0,0 +1,322 @@
+package spec
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"gopkg.in/yaml.v3"
+)
+
+// Problem is a value of a spec file that doesn't match the schema of the spec.
+type Problem struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	if p.Field == "" {
+		return fmt.Sprintf("line %d, column %d: %s", p.Line, p.Column, p.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s: %s", p.Line, p.Column, p.Field, p.Message)
+}
+
+// problemRow is the row of a Problem in the table output.
+type problemRow struct {
+	Position string `col-name:"Line:Column"`
+	Field    string `col-name:"Field"`
+	Message  string `col-name:"Problem"`
+}
+
+func toProblemRows(problems []Problem) []problemRow {
+	rows := make([]problemRow, len(problems))
+	for i, problem := range problems {
+		rows[i] = problemRow{Position: fmt.Sprintf("%d:%d", problem.Line, problem.Column), Field: problem.Field, Message: problem.Message}
+	}
+	return rows
+}
+
+// Validate returns the problems of the documents of a spec file of specType, as parsed by utils.ParseSpecDocuments.
+// Manifest files hold a manifest in each document, and the other spec files hold a single document.
+func Validate(specType string, documents []*yaml.Node) ([]Problem, error) {
+	schema, err := SchemaOf(specType)
+	if err != nil {
+		return nil, err
+	}
+	var problems []Problem
+	for i, document := range documents {
+		if i > 0 && specType != SpecTypeManifest {
+			v := &specValidator{}
+			v.report(document, "", "a spec file of type '%s' must have a single document", specType)
+			return append(problems, v.problems...), nil
+		}
+		problems = append(problems, validateSpec(schema, document)...)
+	}
+	return problems, nil
+}
+
+// validateSpec returns the problems of the spec document against the schema, in the order of the document.
+// It supports the keywords that SchemaOf produces.
+func validateSpec(schema *Schema, document *yaml.Node) []Problem {
+	v := &specValidator{}
+	v.validate(schema, document, "")
+	return v.problems
+}
+
+type specValidator struct {
+	problems []Problem
+}
+
+func (v *specValidator) report(node *yaml.Node, field, format string, args ...interface{}) {
+	line, column := node.Line, node.Column
+	if line == 0 {
+		// The document node of an empty spec.
+		line, column = 1, 1
+	}
+	v.problems = append(v.problems, Problem{Line: line, Column: column, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *specValidator) validate(schema *Schema, node *yaml.Node, field string) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) > 0 {
+			v.validate(schema, node.Content[0], field)
+			return
+		}
+	case yaml.AliasNode:
+		v.validate(schema, node.Alias, field)
+		return
+	}
+
+	if schema.Type != "" && !matchesType(schema.Type, node) {
+		v.report(node, field, "must be %s, found %s", describeSchemaType(schema.Type), nodeTypeName(node))
+		return
+	}
+	if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, node.Value) {
+		v.report(node, field, "invalid value '%s'. Allowed values: %s", node.Value, coreutils.ListToText(schema.Enum))
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		v.validateObject(schema, node, field)
+	case yaml.SequenceNode:
+		if len(node.Content) < schema.MinItems {
+			v.report(node, field, "must have at least %d item(s)", schema.MinItems)
+		}
+		if schema.Items != nil {
+			for i, item := range node.Content {
+				v.validate(schema.Items, item, field+"["+strconv.Itoa(i)+"]")
+			}
+		}
+	}
+	if len(schema.AnyOf) > 0 && !matchesAny(schema.AnyOf, node) {
+		var fields []string
+		for _, branch := range schema.AnyOf {
+			fields = append(fields, branch.Required...)
+		}
+		v.report(node, field, "at least one of %s must be set and not empty", quoteAll(fields))
+	}
+	if len(schema.OneOf) > 0 {
+		v.validateOneOf(schema.OneOf, node, field)
+	}
+}
+
+func (v *specValidator) validateObject(schema *Schema, node *yaml.Node, field string) {
+	present := map[string]bool{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		present[key.Value] = true
+		valueField := joinField(field, key.Value)
+		if property, ok := schema.Properties[key.Value]; ok {
+			v.validate(property, value, valueField)
+			continue
+		}
+		switch additional := schema.AdditionalProperties.(type) {
+		case *Schema:
+			v.validate(additional, value, valueField)
+		case bool:
+			if !additional {
+				v.report(key, valueField, "unknown field '%s'%s", key.Value, suggestField(key.Value, schema.Properties))
+			}
+		}
+	}
+	for _, required := range schema.Required {
+		if !present[required] {
+			v.report(node, field, "missing required field '%s'", required)
+		}
+	}
+	if len(node.Content)/2 < schema.MinProperties {
+		v.report(node, field, "must have at least %d field(s)", schema.MinProperties)
+	}
+}
+
+func matchesAny(branches []*Schema, node *yaml.Node) bool {
+	for _, branch := range branches {
+		if len(validateSpec(branch, node)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateOneOf reports the problems of the branch that declares the most fields of the node, such as the package
+// filter for a node with a package_type field. If no branch declares more fields than the others, the branches are listed.
+func (v *specValidator) validateOneOf(branches []*Schema, node *yaml.Node, field string) {
+	var best []Problem
+	bestKnownFields, tie, matches := -1, false, 0
+	for _, branch := range branches {
+		branchValidator := &specValidator{}
+		branchValidator.validate(branch, node, field)
+		if len(branchValidator.problems) == 0 {
+			matches++
+			continue
+		}
+		switch knownFields := countKnownFields(branch, node); {
+		case knownFields > bestKnownFields:
+			best, bestKnownFields, tie = branchValidator.problems, knownFields, false
+		case knownFields == bestKnownFields:
+			tie = true
+		}
+	}
+	switch {
+	case matches == 1:
+	case matches == 0 && !tie && bestKnownFields > 0:
+		v.problems = append(v.problems, best...)
+	default:
+		var variants []string
+		for _, branch := range branches {
+			variants = append(variants, fmt.Sprintf("%s %s (%s)", indefiniteArticle(branch.Title), branch.Title, strings.Join(sortedKeys(branch.Properties), ", ")))
+		}
+		v.report(node, field, "must be exactly one of %s", strings.Join(variants, " or "))
+	}
+}
+
+func countKnownFields(schema *Schema, node *yaml.Node) int {
+	count := 0
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if _, ok := schema.Properties[node.Content[i].Value]; ok {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func matchesType(schemaType string, node *yaml.Node) bool {
+	switch schemaType {
+	case "object":
+		return node.Kind == yaml.MappingNode
+	case "array":
+		return node.Kind == yaml.SequenceNode
+	case "integer":
+		return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!int"
+	default:
+		return node.Kind == yaml.ScalarNode && nodeTypeName(node) == schemaType
+	}
+}
+
+// nodeTypeName returns the JSON type of the node, with "list" for arrays.
+func nodeTypeName(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "list"
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!str", "!!timestamp":
+			return "string"
+		case "!!int", "!!float":
+			return "number"
+		case "!!bool":
+			return "boolean"
+		case "!!null":
+			return "null"
+		}
+		return strings.TrimPrefix(node.ShortTag(), "!!")
+	default:
+		return "null"
+	}
+}
+
+func describeSchemaType(schemaType string) string {
+	switch schemaType {
+	case "array":
+		return "a list"
+	case "object", "integer":
+		return "an " + schemaType
+	default:
+		return "a " + schemaType
+	}
+}
+
+func indefiniteArticle(noun string) string {
+	if noun != "" && strings.ContainsRune("aeiou", rune(noun[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// suggestField returns a suggestion of the property that an unknown field is likely a misspelling of.
+func suggestField(name string, properties map[string]*Schema) string {
+	suggestion, bestDistance := "", 3
+	for _, property := range sortedKeys(properties) {
+		if distance := editDistance(name, property); distance < bestDistance {
+			suggestion, bestDistance = property, distance
+		}
+	}
+	if suggestion == "" {
+		return ""
+	}
+	return fmt.Sprintf(". Did you mean '%s'?", suggestion)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitution := previous[j-1]
+			if a[i-1] != b[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func sortedKeys(properties map[string]*Schema) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = "'" + value + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,100 @@
+package spec
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+type validateSpecCommand struct {
+	specFilePath string
+	specType     string
+	content      []byte
+	format       string
+}
+
+// specValidationReport is the JSON output of the spec-validate command.
+type specValidationReport struct {
+	File     string    `json:"file"`
+	Type     string    `json:"type"`
+	Valid    bool      `json:"valid"`
+	Problems []Problem `json:"problems"`
+}
+
+func (vsc *validateSpecCommand) Run() error {
+	documents, err := utils.ParseSpecDocuments(vsc.specFilePath, vsc.content)
+	if err != nil {
+		return err
+	}
+	problems, err := Validate(vsc.specType, documents)
+	if err != nil {
+		return err
+	}
+	if problems == nil {
+		problems = []Problem{}
+	}
+	report := specValidationReport{File: vsc.specFilePath, Type: vsc.specType, Valid: len(problems) == 0, Problems: problems}
+	if err = output.PrintWithTable(vsc.format, report, toProblemRows(problems), "The spec file is valid."); err != nil {
+		return err
+	}
+
+	if len(problems) > 0 {
+		return coreutils.CliError{
+			ExitCode: coreutils.ExitCodeError,
+			ErrorMsg: fmt.Sprintf("found %d problem(s) in the spec file '%s'", len(problems), vsc.specFilePath),
+		}
+	}
+	return nil
+}
+
+func (vsc *validateSpecCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	vsc.specFilePath = ctx.Arguments[0]
+
+	vsc.specType = ctx.GetStringFlagValue(commands.SpecTypeFlag)
+	if vsc.specType == "" {
+		return errorutils.CheckErrorf("the --%s flag is mandatory", commands.SpecTypeFlag)
+	}
+	if _, err := utils.ValidateEnumFlag(commands.SpecTypeFlag, vsc.specType, "", SpecTypeValues); err != nil {
+		return err
+	}
+	vsc.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(vsc.format); err != nil {
+		return err
+	}
+
+	var err error
+	vsc.content, err = utils.ReadSpecFile(ctx, vsc.specFilePath)
+	if err != nil {
+		return err
+	}
+	// The command doesn't contact the server, so it has no server to report its usage to.
+	return vsc.Run()
+}
+
+func GetValidateSpecCommand() components.Command {
+	cmd := &validateSpecCommand{}
+	return components.Command{
+		Name:        commands.SpecValidate,
+		Description: "Validate a spec file against the schema of its type, without contacting the server. Reports unknown fields, type errors, missing required fields and invalid values, with their line and column.",
+		Category:    common.CategorySpec,
+		Arguments: []components.Argument{
+			{
+				Name:        "spec-file",
+				Description: "The path of the spec file, in JSON or YAML format.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.SpecValidate),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,97 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSpecFile(t *testing.T, name, content string) string {
+	specFilePath := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(specFilePath, []byte(content), 0600))
+	return specFilePath
+}
+
+func TestValidateSpecCommand(t *testing.T) {
+	validSpec := writeSpecFile(t, "app.yaml", "project_key: ${PROJECT}\ncriticality: high\n")
+	invalidSpec := writeSpecFile(t, "version.json", `{"release_bundle": [{"name": "rb", "version": "1.0.0"}]}`)
+	malformedSpec := writeSpecFile(t, "version.json", `{"packages": [}`)
+
+	tests := []struct {
+		name          string
+		args          []string
+		specType      string
+		specVars      string
+		format        string
+		expectedError string
+		expectedCode  coreutils.ExitCode
+	}{
+		{
+			name:     "valid spec",
+			args:     []string{validSpec},
+			specType: SpecTypeApp,
+			specVars: "PROJECT=proj",
+		},
+		{
+			name:          "invalid spec",
+			args:          []string{invalidSpec},
+			specType:      SpecTypeVersion,
+			format:        output.FormatJson,
+			expectedError: "found 2 problem(s) in the spec file '" + invalidSpec + "'",
+			expectedCode:  coreutils.ExitCodeError,
+		},
+		{
+			name:          "malformed spec",
+			args:          []string{malformedSpec},
+			specType:      SpecTypeVersion,
+			expectedError: "invalid spec file '" + malformedSpec + "': line 1, column 15: invalid character '}' looking for beginning of value",
+		},
+		{
+			name:          "missing spec type",
+			args:          []string{validSpec},
+			expectedError: "the --type flag is mandatory",
+		},
+		{
+			name:          "invalid spec type",
+			args:          []string{validSpec},
+			specType:      "build",
+			expectedError: "invalid value for --type: 'build'. Allowed values: app, version and manifest",
+		},
+		{
+			name:          "missing spec file",
+			args:          []string{filepath.Join(t.TempDir(), "missing.yaml")},
+			specType:      SpecTypeApp,
+			expectedError: "no such file or directory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{Arguments: tt.args}
+			ctx.AddStringFlag(commands.SpecTypeFlag, tt.specType)
+			ctx.AddStringFlag(commands.SpecVarsFlag, tt.specVars)
+			ctx.AddStringFlag(commands.FormatFlag, tt.format)
+
+			cmd := &validateSpecCommand{}
+			err := cmd.prepareAndRunCommand(ctx)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			if tt.expectedCode != (coreutils.ExitCode{}) {
+				var cliError coreutils.CliError
+				require.ErrorAs(t, err, &cliError)
+				assert.Equal(t, tt.expectedCode, cliError.ExitCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validateContent(t *testing.T, specType, specFilePath, content string) []Problem {
+	documents, err := utils.ParseSpecDocuments(specFilePath, []byte(content))
+	require.NoError(t, err)
+	problems, err := Validate(specType, documents)
+	require.NoError(t, err)
+	return problems
+}
+
+func TestValidateSpec(t *testing.T) {
+	tests := []struct {
+		name             string
+		specType         string
+		specFilePath     string
+		content          string
+		expectedProblems []Problem
+	}{
+		{
+			name:         "valid app spec",
+			specType:     SpecTypeApp,
+			specFilePath: "app.yaml",
+			content:      "project_key: proj\nmaturity_level: production\ncriticality: high\nlabels:\n  team: devops\nuser_owners: [jane]\n",
+		},
+		{
+			name:         "valid version spec",
+			specType:     SpecTypeVersion,
+			specFilePath: "version.json",
+			content:      `{"builds": [{"name": "b", "number": "5", "started": "2024-01-31", "include_dependencies": true}], "filters": {"excluded": [{"path": "libs/*.jar"}]}}`,
+		},
+		{
+			name:         "unknown field",
+			specType:     SpecTypeVersion,
+			specFilePath: "version.yaml",
+			content:      "packages:\n  - {type: npm, name: a, version: 1.0.0, repository_key: npm}\nrelease_bundle:\n  - name: rb\n",
+			expectedProblems: []Problem{
+				{Line: 3, Column: 1, Field: "release_bundle", Message: "unknown field 'release_bundle'. Did you mean 'release_bundles'?"},
+			},
+		},
+		{
+			name:         "type errors",
+			specType:     SpecTypeVersion,
+			specFilePath: "version.yaml",
+			content:      "packages:\n  - type: npm\n    name: a\n    version: 1.0\n    repository_key: npm\nbuilds: build-1\n",
+			expectedProblems: []Problem{
+				{Line: 4, Column: 14, Field: "packages[0].version", Message: "must be a string, found number"},
+				{Line: 6, Column: 9, Field: "builds", Message: "must be a list, found string"},
+			},
+		},
+		{
+			name:         "missing required fields",
+			specType:     SpecTypeVersion,
+			specFilePath: "version.json",
+			content:      "{\n  \"versions\": [\n    {\"version\": \"1.0.0\"}\n  ],\n  \"builds\": [{}]\n}",
+			expectedProblems: []Problem{
+				{Line: 3, Column: 5, Field: "versions[0]", Message: "missing required field 'application_key'"},
+				{Line: 5, Column: 14, Field: "builds[0]", Message: "missing required field 'name'"},
+				{Line: 5, Column: 14, Field: "builds[0]", Message: "missing required field 'number'"},
+			},
+		},
+		{
+			name:         "invalid enum values",
+			specType:     SpecTypeApp,
+			specFilePath: "app.yaml",
+			content:      "project_key: proj\nmaturity_level: prod\ncriticality: severe\n",
+			expectedProblems: []Problem{
+				{Line: 2, Column: 17, Field: "maturity_level", Message: "invalid value 'prod'. Allowed values: unspecified, experimental, production and end_of_life"},
+				{Line: 3, Column: 14, Field: "criticality", Message: "invalid value 'severe'. Allowed values: unspecified, low, medium, high and critical"},
+			},
+		},
+		{
+			name:         "missing project key",
+			specType:     SpecTypeApp,
+			specFilePath: "app.yaml",
+			content:      "application_name: my app\n",
+			expectedProblems: []Problem{
+				{Line: 1, Column: 1, Message: "missing required field 'project_key'"},
+			},
+		},
+		{
+			name:         "empty version spec",
+			specType:     SpecTypeVersion,
+			specFilePath: "version.yaml",
+			content:      "packages: []\n",
+			expectedProblems: []Problem{
+				{Line: 1, Column: 1, Message: "at least one of 'artifacts', 'packages', 'builds', 'release_bundles', 'versions' must be set and not empty"},
+			},
+		},
+		{
+			name:         "empty YAML document",
+			specType:     SpecTypeApp,
+			specFilePath: "app.yaml",
+			content:      "# Nothing yet.\n",
+			expectedProblems: []Problem{
+				{Line: 1, Column: 1, Message: "must be an object, found null"},
+			},
+		},
+		{
+			name:         "valid manifests",
+			specType:     SpecTypeManifest,
+			specFilePath: "apps.yaml",
+			content:      "application_key: web\nproject_key: proj\npackages:\n  - {type: npm, name: web-ui, version: 1.2.0}\n---\napplication_key: api\nproject_key: proj\n---\n",
+		},
+		{
+			name:         "invalid manifests",
+			specType:     SpecTypeManifest,
+			specFilePath: "apps.yaml",
+			content:      "application_key: web\nproject_key: proj\nlabel_updates: {}\n---\nproject_key: proj\npackages:\n  - {type: npm, name: web-ui}\n",
+			expectedProblems: []Problem{
+				{Line: 3, Column: 1, Field: "label_updates", Message: "unknown field 'label_updates'"},
+				{Line: 7, Column: 5, Field: "packages[0]", Message: "missing required field 'version'"},
+				{Line: 5, Column: 1, Message: "missing required field 'application_key'"},
+			},
+		},
+		{
+			name:         "app spec with several documents",
+			specType:     SpecTypeApp,
+			specFilePath: "app.yaml",
+			content:      "project_key: proj\n---\nproject_key: other-proj\n",
+			expectedProblems: []Problem{
+				{Line: 2, Column: 1, Message: "a spec file of type 'app' must have a single document"},
+			},
+		},
+		{
+			name:         "filter of a known type with a type error",
+			specType:     SpecTypeVersion,
+			specFilePath: "version.yaml",
+			content:      "artifacts: [{path: repo/a.jar}]\nfilters:\n  included:\n    - package_type: 5\n",
+			expectedProblems: []Problem{
+				{Line: 4, Column: 21, Field: "filters.included[0].package_type", Message: "must be a string, found number"},
+			},
+		},
+		{
+			name:         "filter that mixes filter types",
+			specType:     SpecTypeVersion,
+			specFilePath: "version.yaml",
+			content:      "artifacts: [{path: repo/a.jar}]\nfilters:\n  excluded:\n    - package_name: frontend\n      sha256: abc\n",
+			expectedProblems: []Problem{
+				{Line: 4, Column: 7, Field: "filters.excluded[0]", Message: "must be exactly one of a package filter (package_name, package_type, package_version) or an artifact filter (path, sha256)"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedProblems, validateContent(t, tt.specType, tt.specFilePath, tt.content))
+		})
+	}
+}
+
+// TestValidateSpec_TestFiles validates the spec files of the tests of the commands that accept --spec.
+func TestValidateSpec_TestFiles(t *testing.T) {
+	tests := []struct {
+		specType string
+		file     string
+		valid    bool
+	}{
+		{SpecTypeApp, "../application/testfiles/full-spec.json", true},
+		{SpecTypeApp, "../application/testfiles/minimal-spec.yaml", true},
+		{SpecTypeApp, "../application/testfiles/missing-project-spec.json", false},
+		{SpecTypeApp, "../application/testfiles/invalid-type-spec.yaml", false},
+		{SpecTypeManifest, "../application/testfiles/apps-manifest.yaml", true},
+		{SpecTypeVersion, "../version/testfiles/all-sources-spec.json", true},
+		{SpecTypeVersion, "../version/testfiles/filters-spec.json", true},
+		{SpecTypeVersion, "../version/testfiles/test-spec.json", true},
+		{SpecTypeVersion, "../version/testfiles/minimal-spec.yaml", true},
+		{SpecTypeVersion, "../version/testfiles/unknown-fields-spec.json", false},
+		{SpecTypeVersion, "../version/testfiles/empty-spec.json", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(filepath.Base(tt.file), func(t *testing.T) {
+			content, err := os.ReadFile(tt.file)
+			require.NoError(t, err)
+			problems := validateContent(t, tt.specType, tt.file, string(content))
+			assert.Equal(t, tt.valid, len(problems) == 0, "problems: %v", problems)
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("builds", "builds"))
+	assert.Equal(t, 1, editDistance("release_bundle", "release_bundles"))
+	assert.Equal(t, 2, editDistance("pakage_typ", "package_type"))
+	assert.Equal(t, 3, editDistance("", "abc"))
+}
@@ -0,0 +1,405 @@
+package system
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/auth/cert"
+)
+
+// Statuses of the doctor checks.
+const (
+	checkPassed  = "pass"
+	checkWarning = "warn"
+	checkFailed  = "fail"
+	checkSkipped = "skip"
+)
+
+const (
+	// dialTimeout bounds the DNS lookup and the TLS handshake.
+	dialTimeout = 10 * time.Second
+	// slowPing is the ping latency above which a warning is reported.
+	slowPing = time.Second
+	// certificateExpiryWarning and tokenExpiryWarning are the remaining validity below which a warning is reported.
+	certificateExpiryWarning = 30 * 24 * time.Hour
+	tokenExpiryWarning       = 7 * 24 * time.Hour
+)
+
+const (
+	connectivityHint = "Check that the platform URL is correct, and that the server is reachable from this machine. " +
+		"If a proxy is required, set the HTTPS_PROXY environment variable."
+	tokenSourcesHint = "Set --access-token, --access-token-file, --oidc-provider or the JFROG_APPTRUST_ACCESS_TOKEN environment variable, " +
+		"or configure the server with 'jf config'."
+)
+
+// doctorCheck is the result of a single check, and a row of the doctor report.
+type doctorCheck struct {
+	Name    string `json:"name" col-name:"Check"`
+	Status  string `json:"status" col-name:"Status"`
+	Details string `json:"details" col-name:"Details"`
+	Hint    string `json:"hint,omitempty" col-name:"Hint"`
+}
+
+// tokenClaims are the claims of a JFrog access token that the doctor reports.
+type tokenClaims struct {
+	Subject        string `json:"sub"`
+	Scope          string `json:"scp"`
+	ExpirationTime int64  `json:"exp"`
+}
+
+// runChecks runs the checks in order. When the DNS, TLS or ping check fails, the server is unreachable,
+// and the checks that send requests to it are skipped.
+func (dc *doctorCommand) runChecks(ctx service.Context) []doctorCheck {
+	var checks []doctorCheck
+	failedConnectivityCheck := ""
+	run := func(name string, connectivity bool, check func() doctorCheck) {
+		if failedConnectivityCheck != "" {
+			checks = append(checks, doctorCheck{Name: name, Status: checkSkipped,
+				Details: fmt.Sprintf("Skipped because the %s check failed.", failedConnectivityCheck)})
+			return
+		}
+		result := check()
+		if connectivity && result.Status == checkFailed {
+			failedConnectivityCheck = result.Name
+		}
+		checks = append(checks, result)
+	}
+
+	serverUrl, err := url.Parse(dc.serverDetails.Url)
+	run("DNS", true, func() doctorCheck {
+		if err != nil || serverUrl.Hostname() == "" {
+			return doctorCheck{Name: "DNS", Status: checkFailed, Details: fmt.Sprintf("Invalid platform URL '%s'.", dc.serverDetails.Url),
+				Hint: "Set the platform URL with --url, e.g., https://acme.jfrog.io."}
+		}
+		return checkDns(ctx.GetContext(), serverUrl.Hostname())
+	})
+	run("TLS", true, func() doctorCheck { return dc.checkTls(ctx.GetContext(), serverUrl) })
+	run("Ping", true, func() doctorCheck { return dc.checkPing(ctx) })
+	// The token is decoded locally, so it is checked even when the server is unreachable.
+	checks = append(checks, dc.checkToken(ctx.GetContext(), time.Now()))
+	run("Server version", false, func() doctorCheck { return dc.checkVersion(ctx) })
+
+	if dc.projectKey == "" && dc.applicationKey == "" {
+		run("Read access", false, func() doctorCheck { return dc.checkListApplications(ctx) })
+	}
+	if dc.projectKey != "" {
+		run("Project access", false, func() doctorCheck { return dc.checkListApplications(ctx) })
+	}
+	if dc.applicationKey != "" {
+		run("Application access", false, func() doctorCheck { return dc.checkApplication(ctx) })
+	}
+	return checks
+}
+
+func countFailedChecks(checks []doctorCheck) int {
+	failed := 0
+	for _, check := range checks {
+		if check.Status == checkFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+func checkDns(ctx context.Context, host string) doctorCheck {
+	check := doctorCheck{Name: "DNS"}
+	if net.ParseIP(host) != nil {
+		check.Status, check.Details = checkPassed, fmt.Sprintf("%s is an IP address.", host)
+		return check
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	started := time.Now()
+	addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		check.Status, check.Details = checkFailed, fmt.Sprintf("Failed to resolve %s: %s", host, err.Error())
+		check.Hint = "Check the host name of the platform URL, and the DNS settings of this machine."
+		return check
+	}
+	check.Status = checkPassed
+	check.Details = fmt.Sprintf("%s resolved to %s in %s.", host, strings.Join(addresses, ", "), elapsedSince(started))
+	return check
+}
+
+func (dc *doctorCommand) checkTls(ctx context.Context, serverUrl *url.URL) doctorCheck {
+	check := doctorCheck{Name: "TLS"}
+	if serverUrl.Scheme != "https" {
+		check.Status, check.Details = checkWarning, "The platform URL uses HTTP, so the connection isn't encrypted."
+		check.Hint = "Use an https:// platform URL."
+		return check
+	}
+	if proxyUrl, err := http.ProxyFromEnvironment(&http.Request{URL: serverUrl}); err == nil && proxyUrl != nil {
+		check.Status, check.Details = checkSkipped, fmt.Sprintf("The connection goes through the proxy %s.", proxyUrl.Host)
+		return check
+	}
+
+	tlsConfig, err := dc.tlsConfig()
+	if err != nil {
+		check.Status, check.Details = checkFailed, fmt.Sprintf("Failed to load the trusted certificates: %s", err.Error())
+		return check
+	}
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	dialer := &tls.Dialer{Config: tlsConfig}
+	started := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", hostPort(serverUrl))
+	if err != nil {
+		check.Status, check.Details, check.Hint = checkFailed, fmt.Sprintf("The TLS handshake failed: %s", err.Error()), tlsHint(err)
+		return check
+	}
+	defer func() { _ = conn.Close() }()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	certificate := state.PeerCertificates[0]
+	check.Status = checkPassed
+	check.Details = fmt.Sprintf("%s handshake in %s. The certificate of %s expires on %s.",
+		tls.VersionName(state.Version), elapsedSince(started), certificate.Subject.CommonName, certificate.NotAfter.Format(time.DateOnly))
+	switch {
+	case dc.serverDetails.InsecureTls:
+		check.Status = checkWarning
+		check.Details += " Certificate verification is disabled."
+		check.Hint = "Disable insecure TLS in the server configuration, and trust the server certificate instead."
+	case time.Until(certificate.NotAfter) < certificateExpiryWarning:
+		check.Status = checkWarning
+		check.Hint = "Renew the TLS certificate of the server before it expires."
+	}
+	return check
+}
+
+// tlsConfig trusts the system certificates and the certificates of the JFrog CLI, as the HTTP client does.
+func (dc *doctorCommand) tlsConfig() (*tls.Config, error) {
+	certsDir, err := coreutils.GetJfrogCertsDir()
+	if err != nil {
+		return nil, err
+	}
+	transport, err := cert.GetTransportWithLoadedCert(certsDir, dc.serverDetails.InsecureTls, &http.Transport{})
+	if err != nil {
+		return nil, err
+	}
+	return transport.TLSClientConfig, nil
+}
+
+func hostPort(serverUrl *url.URL) string {
+	if port := serverUrl.Port(); port != "" {
+		return serverUrl.Host
+	}
+	return net.JoinHostPort(serverUrl.Hostname(), "443")
+}
+
+func tlsHint(err error) string {
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCertificate x509.CertificateInvalidError
+	switch {
+	case errors.As(err, &unknownAuthority):
+		certsDir, _ := coreutils.GetJfrogCertsDir()
+		return fmt.Sprintf("The server certificate isn't signed by a trusted certificate authority. Add the CA certificate to %s.", certsDir)
+	case errors.As(err, &hostnameErr):
+		return "The server certificate doesn't match the host name. Check the host name of the platform URL."
+	case errors.As(err, &invalidCertificate):
+		return "The server certificate expired or isn't valid yet. Renew the certificate of the server, and check the clock of this machine."
+	default:
+		return connectivityHint
+	}
+}
+
+func (dc *doctorCommand) checkPing(ctx service.Context) doctorCheck {
+	check := doctorCheck{Name: "Ping"}
+	started := time.Now()
+	if _, err := dc.systemService.Ping(ctx); err != nil {
+		check.Status, check.Details, check.Hint = checkFailed, err.Error(), requestHint(err, "")
+		return check
+	}
+	latency := elapsedSince(started)
+	check.Status, check.Details = checkPassed, fmt.Sprintf("AppTrust responded in %s.", latency)
+	if latency > slowPing {
+		check.Status = checkWarning
+		check.Hint = "The latency is high. Commands may be slow, and may need a longer --timeout."
+	}
+	return check
+}
+
+func (dc *doctorCommand) checkToken(ctx context.Context, now time.Time) doctorCheck {
+	check := doctorCheck{Name: "Access token"}
+	token := dc.serverDetails.AccessToken
+	if dc.tokenSource != nil {
+		var err error
+		if token, err = dc.tokenSource.Token(ctx, dc.serverDetails, false); err != nil {
+			check.Status, check.Details = checkFailed, err.Error()
+			check.Hint = "Check the name of the OIDC integration in --oidc-provider, and that the integration trusts the issuer of the ID token."
+			return check
+		}
+	}
+	if token == "" {
+		check.Status, check.Details, check.Hint = checkFailed, "No access token is set.", tokenSourcesHint
+		return check
+	}
+
+	claims, ok := decodeTokenClaims(token)
+	if !ok {
+		check.Status, check.Details = checkPassed, "A reference token. Its expiry can't be checked locally."
+		return check
+	}
+	details := []string{fmt.Sprintf("Subject %s", claims.Subject)}
+	if claims.Scope != "" {
+		details = append(details, fmt.Sprintf("scope %s", claims.Scope))
+	}
+	check.Status = checkPassed
+	if claims.ExpirationTime == 0 {
+		details = append(details, "doesn't expire")
+	} else {
+		expiry := time.Unix(claims.ExpirationTime, 0)
+		remaining := expiry.Sub(now)
+		switch {
+		case remaining <= 0:
+			check.Status = checkFailed
+			details = append(details, fmt.Sprintf("expired on %s", expiry.UTC().Format(time.DateTime)))
+			check.Hint = "Create a new access token. " + tokenSourcesHint
+		case remaining < tokenExpiryWarning:
+			check.Status = checkWarning
+			details = append(details, fmt.Sprintf("expires in %s", formatRemaining(remaining)))
+			check.Hint = "The access token expires soon. Create a new access token before it expires."
+		default:
+			details = append(details, fmt.Sprintf("expires in %s", formatRemaining(remaining)))
+		}
+	}
+	check.Details = strings.Join(details, ", ") + "."
+	return check
+}
+
+// decodeTokenClaims decodes the claims of a JWT access token, without verifying its signature.
+// It returns false for reference tokens and other tokens that aren't JWTs.
+func decodeTokenClaims(token string) (tokenClaims, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return tokenClaims{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return tokenClaims{}, false
+	}
+	var claims tokenClaims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return tokenClaims{}, false
+	}
+	return claims, true
+}
+
+// formatRemaining formats a duration in days, hours or minutes.
+func formatRemaining(duration time.Duration) string {
+	switch {
+	case duration >= 48*time.Hour:
+		return fmt.Sprintf("%d days", int(duration/(24*time.Hour)))
+	case duration >= 2*time.Hour:
+		return fmt.Sprintf("%d hours", int(duration/time.Hour))
+	default:
+		return fmt.Sprintf("%d minutes", int(duration/time.Minute))
+	}
+}
+
+func (dc *doctorCommand) checkVersion(ctx service.Context) doctorCheck {
+	check := doctorCheck{Name: "Server version"}
+	version, err := dc.systemService.GetVersion(ctx)
+	if err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			check.Status, check.Details = checkWarning, "The server doesn't report its version."
+			check.Hint = "The server may run an earlier AppTrust version, which doesn't support all the commands."
+			return check
+		}
+		check.Status, check.Details, check.Hint = checkFailed, err.Error(), requestHint(err, "")
+		return check
+	}
+	check.Status, check.Details = checkPassed, fmt.Sprintf("AppTrust %s.", version.Version)
+	if version.Revision != "" {
+		check.Details = fmt.Sprintf("AppTrust %s (revision %s).", version.Version, version.Revision)
+	}
+	return check
+}
+
+// checkListApplications reads the first page of the applications, of the project if one is given.
+func (dc *doctorCommand) checkListApplications(ctx service.Context) doctorCheck {
+	check := doctorCheck{Name: "Read access"}
+	details := "Listed the applications."
+	if dc.projectKey != "" {
+		check.Name = "Project access"
+		details = fmt.Sprintf("Listed the applications of the %s project.", dc.projectKey)
+	}
+	for _, err := range dc.applicationService.ListApplications(ctx, &model.ListApplicationsRequest{ProjectKey: dc.projectKey}) {
+		if err != nil {
+			check.Status, check.Details, check.Hint = checkFailed, err.Error(), requestHint(err, "")
+			if dc.projectKey != "" && statusCode(err) == http.StatusNotFound {
+				check.Hint = fmt.Sprintf("The %s project wasn't found. Check the project key.", dc.projectKey)
+			}
+			return check
+		}
+		break
+	}
+	check.Status, check.Details = checkPassed, details
+	return check
+}
+
+func (dc *doctorCommand) checkApplication(ctx service.Context) doctorCheck {
+	check := doctorCheck{Name: "Application access"}
+	application, err := dc.applicationService.GetApplication(ctx, dc.applicationKey)
+	if err != nil {
+		check.Status, check.Details, check.Hint = checkFailed, err.Error(), requestHint(err, dc.applicationKey)
+		return check
+	}
+	check.Status, check.Details = checkPassed, fmt.Sprintf("Read the %s application of the %s project.", application.ApplicationKey, application.ProjectKey)
+	return check
+}
+
+// requestHint suggests how to fix a failed request, from the status code of the response.
+func requestHint(err error, applicationKey string) string {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return "The request timed out or was interrupted. Run the command again, with a longer --timeout if it is set."
+	}
+	switch statusCode(err) {
+	case 0:
+		return connectivityHint
+	case http.StatusUnauthorized:
+		return "The access token was rejected. Check that it is valid, that it wasn't revoked, and that it was issued by this JFrog Platform."
+	case http.StatusForbidden:
+		return "The access token lacks the required permissions. Ask a JFrog Platform administrator to grant its user read access to AppTrust."
+	case http.StatusNotFound:
+		if applicationKey != "" {
+			return fmt.Sprintf("The %s application wasn't found. Check the application key, and that the access token can read the project of the application.", applicationKey)
+		}
+		return "The AppTrust API wasn't found. Check that the platform URL is the URL of the JFrog Platform, and that AppTrust is enabled."
+	default:
+		return "The server failed to process the request. Run the command again later, or contact the JFrog Platform administrator."
+	}
+}
+
+// statusCode returns the status code of the AppTrust error response, or 0 if the request failed without a response.
+func statusCode(err error) int {
+	var apptrustErr *apphttp.ApptrustError
+	if errors.As(err, &apptrustErr) {
+		return apptrustErr.StatusCode
+	}
+	return 0
+}
+
+// elapsedSince returns the time elapsed since started, rounded for display.
+func elapsedSince(started time.Time) time.Duration {
+	elapsed := time.Since(started)
+	if elapsed < time.Millisecond {
+		return elapsed.Round(time.Microsecond)
+	}
+	return elapsed.Round(time.Millisecond)
+}
@@ -0,0 +1,120 @@
+package system
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/systems"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+type doctorCommand struct {
+	systemService      systems.SystemService
+	applicationService applications.ApplicationService
+	serverDetails      *coreConfig.ServerDetails
+	clientOptions      []apphttp.ClientOption
+	// tokenSource provides the access token when it is exchanged with --oidc-provider.
+	tokenSource    apphttp.TokenSource
+	projectKey     string
+	applicationKey string
+	format         string
+}
+
+// doctorReport is the JSON output of the doctor command.
+type doctorReport struct {
+	Url    string        `json:"url"`
+	Passed bool          `json:"passed"`
+	Checks []doctorCheck `json:"checks"`
+}
+
+func (dc *doctorCommand) Run() error {
+	ctx, err := service.NewContext(*dc.serverDetails, dc.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	checks := dc.runChecks(ctx)
+	failed := countFailedChecks(checks)
+	report := doctorReport{Url: dc.serverDetails.Url, Passed: failed == 0, Checks: checks}
+	if err = output.PrintWithTable(dc.format, report, checks, ""); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return coreutils.CliError{
+			ExitCode: coreutils.ExitCodeError,
+			ErrorMsg: fmt.Sprintf("%d of %d checks failed", failed, len(checks)),
+		}
+	}
+	return nil
+}
+
+func (dc *doctorCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return dc.serverDetails, nil
+}
+
+func (dc *doctorCommand) CommandName() string {
+	return commands.Doctor
+}
+
+func (dc *doctorCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	dc.projectKey = ctx.GetStringFlagValue(commands.ProjectFlag)
+	dc.applicationKey = ctx.GetStringFlagValue(commands.ApplicationFlag)
+	dc.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(dc.format); err != nil {
+		return err
+	}
+
+	var err error
+	dc.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	tokenSource, err := utils.OidcTokenSourceByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	if tokenSource != nil {
+		dc.tokenSource = tokenSource
+	}
+	var release func()
+	dc.clientOptions, release, err = utils.ClientOptionsWithTokenSource(ctx, tokenSource)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Failed requests are reported rather than retried, unless retries are set by --retries or its environment variable.
+	dc.clientOptions = append([]apphttp.ClientOption{apphttp.WithRetries(0)}, dc.clientOptions...)
+
+	return commonCLiCommands.Exec(dc)
+}
+
+func GetDoctorCommand(appContext app.Context) components.Command {
+	cmd := &doctorCommand{
+		systemService:      appContext.GetSystemService(),
+		applicationService: appContext.GetApplicationService(),
+	}
+	return components.Command{
+		Name:        commands.Doctor,
+		Description: "Diagnose the connection, authentication and permissions of the AppTrust commands, and suggest fixes for the checks that fail.",
+		Category:    common.CategorySystem,
+		Arguments:   []components.Argument{},
+		Flags:       commands.GetCommandFlags(commands.Doctor),
+		Action:      cmd.prepareAndRunCommand,
+	}
+}
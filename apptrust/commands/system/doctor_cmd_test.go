@@ -0,0 +1,218 @@
+package system
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/fakeserver"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/systems"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDoctorCommand returns a doctor command for the server, with a test JFrog CLI home directory.
+func newDoctorCommand(t *testing.T, serverDetails *coreConfig.ServerDetails) *doctorCommand {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	return &doctorCommand{
+		systemService:      systems.NewSystemService(),
+		applicationService: applications.NewApplicationService(),
+		serverDetails:      serverDetails,
+		clientOptions:      []apphttp.ClientOption{apphttp.WithRetries(0)},
+		format:             output.FormatJson,
+	}
+}
+
+func runDoctorChecks(t *testing.T, cmd *doctorCommand) map[string]doctorCheck {
+	ctx, err := service.NewContext(*cmd.serverDetails, cmd.clientOptions...)
+	require.NoError(t, err)
+	checks := map[string]doctorCheck{}
+	for _, check := range cmd.runChecks(ctx) {
+		checks[check.Name] = check
+	}
+	return checks
+}
+
+func startDoctorServer(t *testing.T, options ...fakeserver.Option) *coreConfig.ServerDetails {
+	server, serverDetails := fakeserver.StartTestServer(t, options...)
+	require.NoError(t, server.Seed(fakeserver.SeedData{
+		Applications: []model.AppDescriptor{{ApplicationKey: "web", ProjectKey: "proj"}},
+	}))
+	return serverDetails
+}
+
+func TestDoctorCommand_Run(t *testing.T) {
+	cmd := newDoctorCommand(t, startDoctorServer(t))
+	cmd.projectKey = "proj"
+	cmd.applicationKey = "web"
+	require.NoError(t, cmd.Run())
+
+	checks := runDoctorChecks(t, cmd)
+	assert.Len(t, checks, 7)
+	assert.Equal(t, checkPassed, checks["DNS"].Status)
+	// The test server doesn't use TLS.
+	assert.Equal(t, checkWarning, checks["TLS"].Status)
+	assert.Equal(t, checkPassed, checks["Ping"].Status)
+	assert.Equal(t, checkPassed, checks["Access token"].Status)
+	assert.Equal(t, "AppTrust "+fakeserver.Version+" (revision fake).", checks["Server version"].Details)
+	assert.Equal(t, checkPassed, checks["Project access"].Status)
+	assert.Equal(t, doctorCheck{Name: "Application access", Status: checkPassed, Details: "Read the web application of the proj project."}, checks["Application access"])
+}
+
+func TestDoctorCommand_Run_ReadAccess(t *testing.T) {
+	cmd := newDoctorCommand(t, startDoctorServer(t))
+	checks := runDoctorChecks(t, cmd)
+	assert.Equal(t, doctorCheck{Name: "Read access", Status: checkPassed, Details: "Listed the applications."}, checks["Read access"])
+	assert.NotContains(t, checks, "Project access")
+	assert.NotContains(t, checks, "Application access")
+}
+
+func TestDoctorCommand_Run_Unauthorized(t *testing.T) {
+	serverDetails := startDoctorServer(t, fakeserver.WithAccessToken("valid-token"))
+	serverDetails.AccessToken = "revoked-token"
+	cmd := newDoctorCommand(t, serverDetails)
+
+	err := cmd.Run()
+	var cliError coreutils.CliError
+	require.ErrorAs(t, err, &cliError)
+	assert.Equal(t, coreutils.ExitCodeError, cliError.ExitCode)
+	assert.Equal(t, "1 of 6 checks failed", cliError.ErrorMsg)
+
+	checks := runDoctorChecks(t, cmd)
+	assert.Equal(t, checkFailed, checks["Ping"].Status)
+	assert.Contains(t, checks["Ping"].Hint, "The access token was rejected")
+	assert.Equal(t, checkSkipped, checks["Server version"].Status)
+	assert.Equal(t, "Skipped because the Ping check failed.", checks["Read access"].Details)
+}
+
+func TestDoctorCommand_Run_Unreachable(t *testing.T) {
+	server := httptest.NewServer(fakeserver.New())
+	server.Close()
+	cmd := newDoctorCommand(t, &coreConfig.ServerDetails{Url: server.URL + "/", AccessToken: "token"})
+
+	checks := runDoctorChecks(t, cmd)
+	assert.Equal(t, checkFailed, checks["Ping"].Status)
+	assert.Equal(t, connectivityHint, checks["Ping"].Hint)
+	assert.Equal(t, checkSkipped, checks["Server version"].Status)
+}
+
+func TestDoctorCommand_Run_ApplicationNotFound(t *testing.T) {
+	cmd := newDoctorCommand(t, startDoctorServer(t))
+	cmd.applicationKey = "missing"
+
+	checks := runDoctorChecks(t, cmd)
+	assert.Equal(t, checkFailed, checks["Application access"].Status)
+	assert.Contains(t, checks["Application access"].Hint, "The missing application wasn't found")
+}
+
+func TestDoctorCommand_Run_Tls(t *testing.T) {
+	server := httptest.NewTLSServer(fakeserver.New())
+	t.Cleanup(server.Close)
+
+	// The certificate of the test server isn't signed by a trusted certificate authority.
+	cmd := newDoctorCommand(t, &coreConfig.ServerDetails{Url: server.URL + "/", AccessToken: "token"})
+	checks := runDoctorChecks(t, cmd)
+	assert.Equal(t, checkFailed, checks["TLS"].Status)
+	assert.Contains(t, checks["TLS"].Hint, "Add the CA certificate to")
+	assert.Equal(t, "Skipped because the TLS check failed.", checks["Ping"].Details)
+
+	cmd = newDoctorCommand(t, &coreConfig.ServerDetails{Url: server.URL + "/", AccessToken: "token", InsecureTls: true})
+	checks = runDoctorChecks(t, cmd)
+	assert.Equal(t, checkWarning, checks["TLS"].Status)
+	assert.Contains(t, checks["TLS"].Details, "Certificate verification is disabled.")
+	assert.Equal(t, checkPassed, checks["Ping"].Status)
+}
+
+// testJwt returns an unsigned JWT with the given claims.
+func testJwt(t *testing.T, claims map[string]interface{}) string {
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+type failingTokenSource struct{}
+
+func (failingTokenSource) Token(context.Context, *coreConfig.ServerDetails, bool) (string, error) {
+	return "", errors.New("OIDC provider 'gitlab' not found")
+}
+
+func TestDoctorCommand_CheckToken(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	subject := "jfac@01/users/ci"
+
+	tests := []struct {
+		name            string
+		token           string
+		tokenSource     apphttp.TokenSource
+		expectedStatus  string
+		expectedDetails string
+	}{
+		{
+			name:            "valid",
+			token:           testJwt(t, map[string]interface{}{"sub": subject, "scp": "applied-permissions/user", "exp": now.Add(30 * 24 * time.Hour).Unix()}),
+			expectedStatus:  checkPassed,
+			expectedDetails: fmt.Sprintf("Subject %s, scope applied-permissions/user, expires in 30 days.", subject),
+		},
+		{
+			name:            "without expiry",
+			token:           testJwt(t, map[string]interface{}{"sub": subject}),
+			expectedStatus:  checkPassed,
+			expectedDetails: fmt.Sprintf("Subject %s, doesn't expire.", subject),
+		},
+		{
+			name:            "expires soon",
+			token:           testJwt(t, map[string]interface{}{"sub": subject, "exp": now.Add(5 * time.Hour).Unix()}),
+			expectedStatus:  checkWarning,
+			expectedDetails: fmt.Sprintf("Subject %s, expires in 5 hours.", subject),
+		},
+		{
+			name:            "expired",
+			token:           testJwt(t, map[string]interface{}{"sub": subject, "exp": now.Add(-time.Hour).Unix()}),
+			expectedStatus:  checkFailed,
+			expectedDetails: fmt.Sprintf("Subject %s, expired on 2025-12-31 23:00:00.", subject),
+		},
+		{
+			name:            "reference token",
+			token:           "cmVmdGtuOjAxOjE3",
+			expectedStatus:  checkPassed,
+			expectedDetails: "A reference token. Its expiry can't be checked locally.",
+		},
+		{
+			name:            "no token",
+			expectedStatus:  checkFailed,
+			expectedDetails: "No access token is set.",
+		},
+		{
+			name:            "OIDC exchange failure",
+			tokenSource:     failingTokenSource{},
+			expectedStatus:  checkFailed,
+			expectedDetails: "OIDC provider 'gitlab' not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &doctorCommand{serverDetails: &coreConfig.ServerDetails{Url: "https://acme.jfrog.io/", AccessToken: tt.token}, tokenSource: tt.tokenSource}
+			check := cmd.checkToken(context.Background(), now)
+			assert.Equal(t, tt.expectedStatus, check.Status)
+			assert.Equal(t, tt.expectedDetails, check.Details)
+			if tt.expectedStatus == checkPassed {
+				assert.Empty(t, check.Hint)
+			} else {
+				assert.NotEmpty(t, check.Hint)
+			}
+		})
+	}
+}
@@ -7,25 +7,33 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/systems"
 	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type pingCommand struct {
 	systemService systems.SystemService
 	serverDetails *coreConfig.ServerDetails
+	clientOptions []apphttp.ClientOption
 }
 
 func (pc *pingCommand) Run() error {
-	ctx, err := service.NewContext(*pc.serverDetails)
+	ctx, err := service.NewContext(*pc.serverDetails, pc.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return pc.systemService.Ping(ctx)
+	body, err := pc.systemService.Ping(ctx)
+	if err != nil {
+		return err
+	}
+	log.Output(body)
+	return nil
 }
 
 func (pc *pingCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -42,6 +50,12 @@ func (pc *pingCommand) prepareAndRunCommand(ctx *components.Context) error {
 		return err
 	}
 	pc.serverDetails = serverDetails
+	var release func()
+	pc.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 	return commonCLiCommands.Exec(pc)
 }
 
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ErrInterrupted is the cancellation cause of a command interrupted by the user.
+var ErrInterrupted = fmt.Errorf("interrupted by the user: %w", context.Canceled)
+
+// NewCommandContext returns a context that is canceled with ErrInterrupted when the user presses Ctrl-C,
+// or when timeout elapses, if timeout is positive. A second Ctrl-C terminates the process as usual.
+// The returned release function must be called when the command completes.
+func NewCommandContext(timeout time.Duration) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	go func() {
+		select {
+		case <-interrupts:
+			signal.Stop(interrupts)
+			log.Warn("Interrupted. Canceling the request in progress...")
+			cancel(ErrInterrupted)
+		case <-ctx.Done():
+		}
+	}()
+	release := func() {
+		signal.Stop(interrupts)
+		cancel(nil)
+	}
+
+	if timeout <= 0 {
+		return ctx, release
+	}
+	timeoutCtx, cancelTimeout := context.WithTimeoutCause(ctx, timeout,
+		fmt.Errorf("the command timed out after %s: %w", timeout, context.DeadlineExceeded))
+	return timeoutCtx, func() {
+		cancelTimeout()
+		release()
+	}
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCommandContext(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		ctx, release := NewCommandContext(10 * time.Millisecond)
+		defer release()
+
+		<-ctx.Done()
+		assert.ErrorIs(t, context.Cause(ctx), context.DeadlineExceeded)
+		assert.EqualError(t, context.Cause(ctx), "the command timed out after 10ms: context deadline exceeded")
+	})
+
+	t.Run("interrupt", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("sending an interrupt signal is not supported on Windows")
+		}
+		ctx, release := NewCommandContext(0)
+		defer release()
+
+		process, err := os.FindProcess(os.Getpid())
+		assert.NoError(t, err)
+		assert.NoError(t, process.Signal(os.Interrupt))
+
+		select {
+		case <-ctx.Done():
+			assert.ErrorIs(t, context.Cause(ctx), ErrInterrupted)
+			assert.ErrorIs(t, ctx.Err(), context.Canceled)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the context was not canceled on interrupt")
+		}
+	})
+
+	t.Run("release", func(t *testing.T) {
+		ctx, release := NewCommandContext(time.Hour)
+		release()
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+}
@@ -0,0 +1,207 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/oidc"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// credentialHelperGet is the argument with which a credential helper is run to get the access token.
+const credentialHelperGet = "get"
+
+// credentialHelperRequest is written as JSON to the stdin of a credential helper.
+type credentialHelperRequest struct {
+	ServerURL string `json:"ServerURL"`
+}
+
+// credentialHelperResponse is read as JSON from the stdout of a credential helper.
+// Its fields follow the Docker credential helpers, and Username is ignored.
+type credentialHelperResponse struct {
+	ServerURL string `json:"ServerURL,omitempty"`
+	Username  string `json:"Username,omitempty"`
+	Secret    string `json:"Secret"`
+}
+
+// applyAccessTokenChain sets the access token of serverDetails from the first of the following sources that provides one:
+//  1. The --access-token flag, which is already set in serverDetails.
+//  2. The file of the --access-token-file flag.
+//  3. The OIDC token exchange of the --oidc-provider flag, or of the JFROG_APPTRUST_OIDC_PROVIDER environment variable.
+//     The access token is left empty, since it is obtained by the token source of OidcTokenSourceByFlags,
+//     which reads the ID token when ClientOptionsByFlags builds it.
+//  4. The JFROG_APPTRUST_ACCESS_TOKEN environment variable.
+//  5. The credential helper of the --credential-helper flag, or of the JFROG_APPTRUST_CREDENTIAL_HELPER environment variable.
+//     With --plan, the access token is left empty, and the helper is run by the token source that ClientOptionsByFlags adds.
+//
+// If none of them provides a token, serverDetails keeps the credentials of the configured server.
+// A token from the chain replaces the configured credentials, so that they are never mixed.
+func applyAccessTokenChain(ctx *components.Context, serverDetails *coreConfig.ServerDetails) error {
+	if ctx.GetStringFlagValue(commands.AccessTokenFlag) != "" {
+		return nil
+	}
+	token, source, err := accessTokenFromChain(ctx, serverDetails.Url)
+	if err != nil || source == "" {
+		return err
+	}
+	log.Debug("Using the access token from", source)
+	serverDetails.AccessToken = token
+	serverDetails.User = ""
+	serverDetails.Password = ""
+	serverDetails.RefreshToken = ""
+	serverDetails.ArtifactoryRefreshToken = ""
+	serverDetails.ArtifactoryTokenRefreshInterval = coreutils.TokenRefreshDisabled
+	return nil
+}
+
+// accessTokenFromChain returns the access token of the first source of the chain that provides one, and the name of the source.
+// The name of the source is empty if none of them provides a token.
+func accessTokenFromChain(ctx *components.Context, serverUrl string) (token, source string, err error) {
+	if path := ctx.GetStringFlagValue(commands.AccessTokenFileFlag); path != "" {
+		token, err = readAccessTokenFile(path)
+		return token, "--" + commands.AccessTokenFileFlag, err
+	}
+	if oidcProviderByFlags(ctx) != "" {
+		return "", "the OIDC token exchange", nil
+	}
+	if token = strings.TrimSpace(os.Getenv(commands.AccessTokenEnv)); token != "" {
+		return token, commands.AccessTokenEnv, nil
+	}
+	if helper, helperSource := flagOrEnvValue(ctx, commands.CredentialHelperFlag, commands.CredentialHelperEnv); helper != "" {
+		// With --plan, the credential helper is run by the token source of credentialHelperTokenSourceByFlags,
+		// only if a request is sent.
+		if ctx.GetBoolFlagValue(commands.PlanFlag) {
+			return "", helperSource, nil
+		}
+		token, err = runCredentialHelper(helper, serverUrl)
+		return token, helperSource, err
+	}
+	return "", "", nil
+}
+
+// credentialHelperTokenSource runs the credential helper when the first request is sent, and when the server rejects its token.
+type credentialHelperTokenSource struct {
+	helper string
+
+	mutex sync.Mutex
+	token string
+}
+
+func (s *credentialHelperTokenSource) Token(_ context.Context, serverDetails *coreConfig.ServerDetails, refresh bool) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.token == "" || refresh {
+		token, err := runCredentialHelper(s.helper, serverDetails.Url)
+		if err != nil {
+			return "", err
+		}
+		s.token = token
+	}
+	return s.token, nil
+}
+
+// credentialHelperTokenSourceByFlags returns the token source of the credential helper of the access token chain,
+// which is used with --plan, so that the helper isn't run unless a request is sent. It returns nil if the helper
+// is not set, or if a source that precedes it in the chain provides the token.
+func credentialHelperTokenSourceByFlags(ctx *components.Context) *credentialHelperTokenSource {
+	if ctx.GetStringFlagValue(commands.AccessTokenFlag) != "" || ctx.GetStringFlagValue(commands.AccessTokenFileFlag) != "" ||
+		oidcProviderByFlags(ctx) != "" || strings.TrimSpace(os.Getenv(commands.AccessTokenEnv)) != "" {
+		return nil
+	}
+	helper, _ := flagOrEnvValue(ctx, commands.CredentialHelperFlag, commands.CredentialHelperEnv)
+	if helper == "" {
+		return nil
+	}
+	return &credentialHelperTokenSource{helper: helper}
+}
+
+// OidcTokenSourceByFlags returns the token source of the --oidc-provider flag, which exchanges the ID token of the CI job
+// for access tokens. It returns nil if the flag is not set, or if the --access-token or --access-token-file flag takes precedence.
+func OidcTokenSourceByFlags(ctx *components.Context) (*oidc.TokenSource, error) {
+	provider := oidcProviderByFlags(ctx)
+	if provider == "" {
+		return nil, nil
+	}
+
+	var idToken string
+	if path := ctx.GetStringFlagValue(commands.OidcTokenFileFlag); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed to read the OIDC ID token file: %s", err.Error())
+		}
+		idToken = strings.TrimSpace(string(content))
+	} else {
+		idToken = strings.TrimSpace(os.Getenv(commands.OidcTokenEnv))
+	}
+	if idToken == "" {
+		return nil, errorutils.CheckErrorf("the OIDC ID token is required with --%s. Set it with --%s or the %s environment variable",
+			commands.OidcProviderFlag, commands.OidcTokenFileFlag, commands.OidcTokenEnv)
+	}
+
+	cacheDir, err := oidc.DefaultCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	return oidc.NewTokenSource(provider, idToken, cacheDir), nil
+}
+
+// oidcProviderByFlags returns the OIDC provider of the --oidc-provider flag, or of its environment variable.
+// It returns an empty string if neither is set, or if the --access-token or --access-token-file flag takes precedence.
+func oidcProviderByFlags(ctx *components.Context) string {
+	if ctx.GetStringFlagValue(commands.AccessTokenFlag) != "" || ctx.GetStringFlagValue(commands.AccessTokenFileFlag) != "" {
+		return ""
+	}
+	provider, _ := flagOrEnvValue(ctx, commands.OidcProviderFlag, commands.OidcProviderEnv)
+	return provider
+}
+
+func readAccessTokenFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errorutils.CheckErrorf("failed to read the access token file: %s", err.Error())
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", errorutils.CheckErrorf("the access token file '%s' is empty", path)
+	}
+	return token, nil
+}
+
+// runCredentialHelper runs the helper executable with the 'get' argument, writes the server URL to its stdin,
+// and returns the secret it writes to its stdout.
+func runCredentialHelper(helper, serverUrl string) (string, error) {
+	request, err := json.Marshal(credentialHelperRequest{ServerURL: serverUrl})
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(helper, credentialHelperGet)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", errorutils.CheckErrorf("the credential helper '%s' failed: %s: %s", helper, err.Error(), message)
+		}
+		return "", errorutils.CheckErrorf("the credential helper '%s' failed: %s", helper, err.Error())
+	}
+
+	var response credentialHelperResponse
+	if err = json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return "", errorutils.CheckErrorf("failed to parse the response of the credential helper '%s': %s", helper, err.Error())
+	}
+	if response.Secret == "" {
+		return "", errorutils.CheckErrorf("the credential helper '%s' returned no secret", helper)
+	}
+	return response.Secret, nil
+}
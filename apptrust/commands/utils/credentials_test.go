@@ -0,0 +1,309 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPlatformUrl = "https://acme.jfrog.io/"
+
+// writeCredentialHelper writes an executable that checks the request on its stdin, and writes response to its stdout.
+func writeCredentialHelper(t *testing.T, response string, exitCode int) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test credential helper is a shell script")
+	}
+	path := filepath.Join(t.TempDir(), "credential-helper")
+	script := "#!/bin/sh\n" +
+		"[ \"$1\" = get ] || { echo \"unexpected argument $1\" >&2; exit 3; }\n" +
+		"grep -q '\"ServerURL\":\"" + testPlatformUrl + "\"' || { echo 'unexpected request' >&2; exit 4; }\n" +
+		"echo '" + response + "'\n" +
+		"exit " + strconv.Itoa(exitCode) + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o700))
+	return path
+}
+
+func writeAccessTokenFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestServerDetailsByFlags_AccessTokenChain(t *testing.T) {
+	helper := writeCredentialHelper(t, `{"ServerURL": "`+testPlatformUrl+`", "Secret": "helper-token"}`, 0)
+	tests := []struct {
+		name          string
+		flags         map[string]string
+		env           map[string]string
+		expectedToken string
+		expectedError string
+	}{
+		{
+			name:          "access token flag",
+			flags:         map[string]string{commands.AccessTokenFlag: "flag-token", commands.AccessTokenFileFlag: writeAccessTokenFile(t, "file-token")},
+			env:           map[string]string{commands.AccessTokenEnv: "env-token"},
+			expectedToken: "flag-token",
+		},
+		{
+			name:          "access token file",
+			flags:         map[string]string{commands.AccessTokenFileFlag: writeAccessTokenFile(t, "file-token\n")},
+			env:           map[string]string{commands.AccessTokenEnv: "env-token"},
+			expectedToken: "file-token",
+		},
+		{
+			name:          "environment variable",
+			flags:         map[string]string{commands.CredentialHelperFlag: helper},
+			env:           map[string]string{commands.AccessTokenEnv: "env-token"},
+			expectedToken: "env-token",
+		},
+		{
+			name:          "credential helper flag",
+			flags:         map[string]string{commands.CredentialHelperFlag: helper},
+			expectedToken: "helper-token",
+		},
+		{
+			name:          "credential helper environment variable",
+			env:           map[string]string{commands.CredentialHelperEnv: helper},
+			expectedToken: "helper-token",
+		},
+		{
+			name:          "empty access token file",
+			flags:         map[string]string{commands.AccessTokenFileFlag: writeAccessTokenFile(t, " \n")},
+			expectedError: "is empty",
+		},
+		{
+			name:          "missing access token file",
+			flags:         map[string]string{commands.AccessTokenFileFlag: filepath.Join(t.TempDir(), "missing")},
+			expectedError: "failed to read the access token file",
+		},
+		{
+			name:          "missing credential helper",
+			flags:         map[string]string{commands.CredentialHelperFlag: filepath.Join(t.TempDir(), "missing")},
+			expectedError: "the credential helper",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(coreutils.HomeDir, t.TempDir())
+			t.Setenv(coreutils.CI, "true")
+			t.Setenv(commands.AccessTokenEnv, "")
+			t.Setenv(commands.CredentialHelperEnv, "")
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			ctx := &components.Context{}
+			ctx.AddStringFlag("url", testPlatformUrl)
+			for name, value := range tt.flags {
+				ctx.AddStringFlag(name, value)
+			}
+
+			serverDetails, err := ServerDetailsByFlags(ctx)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testPlatformUrl, serverDetails.Url)
+			assert.Equal(t, tt.expectedToken, serverDetails.AccessToken)
+		})
+	}
+}
+
+// TestServerDetailsByFlags_CredentialHelperWithPlan checks that with --plan, the credential helper is run
+// only when a request is sent.
+func TestServerDetailsByFlags_CredentialHelperWithPlan(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	t.Setenv(coreutils.CI, "true")
+	t.Setenv(commands.AccessTokenEnv, "")
+	t.Setenv(commands.CredentialHelperEnv, "")
+	runs := filepath.Join(t.TempDir(), "runs")
+	helper := writeCredentialHelper(t, `{"Secret": "helper-token"}`, 0)
+	// The helper records its runs, to check when it is run.
+	wrapper := filepath.Join(t.TempDir(), "wrapper")
+	require.NoError(t, os.WriteFile(wrapper, []byte("#!/bin/sh\necho run >> "+runs+"\nexec "+helper+" \"$@\"\n"), 0o700))
+	ctx := &components.Context{}
+	ctx.AddStringFlag("url", testPlatformUrl)
+	ctx.AddStringFlag(commands.CredentialHelperFlag, wrapper)
+	ctx.AddBoolFlag(commands.PlanFlag, true)
+
+	serverDetails, err := ServerDetailsByFlags(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, serverDetails.AccessToken)
+	assert.NoFileExists(t, runs)
+
+	tokenSource := credentialHelperTokenSourceByFlags(ctx)
+	require.NotNil(t, tokenSource)
+	for range 2 {
+		token, err := tokenSource.Token(context.Background(), serverDetails, false)
+		require.NoError(t, err)
+		assert.Equal(t, "helper-token", token)
+	}
+	content, err := os.ReadFile(runs)
+	require.NoError(t, err)
+	assert.Equal(t, "run\n", string(content))
+
+	t.Setenv(commands.AccessTokenEnv, "env-token")
+	assert.Nil(t, credentialHelperTokenSourceByFlags(ctx))
+}
+
+func TestServerDetailsByFlags_AccessTokenReplacesUser(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	t.Setenv(coreutils.CI, "true")
+	t.Setenv(commands.AccessTokenEnv, "env-token")
+	ctx := &components.Context{}
+	ctx.AddStringFlag("url", testPlatformUrl)
+	ctx.AddStringFlag("user", "admin")
+
+	serverDetails, err := ServerDetailsByFlags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "env-token", serverDetails.AccessToken)
+	assert.Empty(t, serverDetails.User)
+}
+
+func TestRunCredentialHelper_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      string
+		exitCode      int
+		expectedError string
+	}{
+		{
+			name:          "failure",
+			response:      "not logged in",
+			exitCode:      1,
+			expectedError: "failed: exit status 1",
+		},
+		{
+			name:          "invalid response",
+			response:      "not json",
+			expectedError: "failed to parse the response of the credential helper",
+		},
+		{
+			name:          "no secret",
+			response:      `{"Username": "admin"}`,
+			expectedError: "returned no secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helper := writeCredentialHelper(t, tt.response, tt.exitCode)
+			_, err := runCredentialHelper(helper, testPlatformUrl)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestOidcTokenSourceByFlags(t *testing.T) {
+	idTokenFile := writeAccessTokenFile(t, "file-id-token\n")
+	tests := []struct {
+		name           string
+		flags          map[string]string
+		env            map[string]string
+		expectedSource bool
+		expectedError  string
+	}{
+		{
+			name: "no provider",
+			env:  map[string]string{commands.OidcTokenEnv: "env-id-token"},
+		},
+		{
+			name:           "provider flag and ID token file",
+			flags:          map[string]string{commands.OidcProviderFlag: "github", commands.OidcTokenFileFlag: idTokenFile},
+			expectedSource: true,
+		},
+		{
+			name:           "environment variables",
+			env:            map[string]string{commands.OidcProviderEnv: "github", commands.OidcTokenEnv: "env-id-token"},
+			expectedSource: true,
+		},
+		{
+			name:  "access token file takes precedence",
+			flags: map[string]string{commands.OidcProviderFlag: "github", commands.AccessTokenFileFlag: idTokenFile},
+			env:   map[string]string{commands.OidcTokenEnv: "env-id-token"},
+		},
+		{
+			name:          "missing ID token",
+			flags:         map[string]string{commands.OidcProviderFlag: "github"},
+			expectedError: "the OIDC ID token is required with --oidc-provider",
+		},
+		{
+			name:          "missing ID token file",
+			flags:         map[string]string{commands.OidcProviderFlag: "github", commands.OidcTokenFileFlag: filepath.Join(t.TempDir(), "missing")},
+			expectedError: "failed to read the OIDC ID token file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(coreutils.HomeDir, t.TempDir())
+			t.Setenv(commands.OidcProviderEnv, "")
+			t.Setenv(commands.OidcTokenEnv, "")
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			ctx := &components.Context{}
+			for name, value := range tt.flags {
+				ctx.AddStringFlag(name, value)
+			}
+
+			tokenSource, err := OidcTokenSourceByFlags(ctx)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSource, tokenSource != nil)
+		})
+	}
+}
+
+func TestServerDetailsByFlags_Oidc(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	t.Setenv(coreutils.CI, "true")
+	t.Setenv(commands.AccessTokenEnv, "env-token")
+	t.Setenv(commands.OidcTokenEnv, "id-token")
+	ctx := &components.Context{}
+	ctx.AddStringFlag("url", testPlatformUrl)
+	ctx.AddStringFlag(commands.OidcProviderFlag, "github")
+
+	// The access token is obtained by the token source of the HTTP client.
+	serverDetails, err := ServerDetailsByFlags(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, serverDetails.AccessToken)
+
+	options, release, err := ClientOptionsByFlags(ctx)
+	require.NoError(t, err)
+	defer release()
+	assert.Len(t, options, 2)
+}
+
+func TestServerDetailsByFlags_OidcIdTokenReadByClientOptions(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	t.Setenv(coreutils.CI, "true")
+	t.Setenv(commands.OidcProviderEnv, "")
+	t.Setenv(commands.OidcTokenEnv, "")
+	ctx := &components.Context{}
+	ctx.AddStringFlag("url", testPlatformUrl)
+	ctx.AddStringFlag(commands.OidcProviderFlag, "github")
+	ctx.AddStringFlag(commands.OidcTokenFileFlag, filepath.Join(t.TempDir(), "missing"))
+
+	// The credential chain only checks that the OIDC token exchange is set. The ID token is read once,
+	// when the token source of the HTTP client is built.
+	serverDetails, err := ServerDetailsByFlags(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, serverDetails.AccessToken)
+
+	_, _, err = ClientOptionsByFlags(ctx)
+	assert.ErrorContains(t, err, "failed to read the OIDC ID token file")
+}
@@ -0,0 +1,401 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadSpecFile reads the spec file of the --spec flag, replaces the variables of the --spec-vars flag,
+// and decodes it into spec, a pointer to a struct with JSON tags.
+// See DecodeSpec for the supported formats.
+func LoadSpecFile(ctx *components.Context, spec interface{}) error {
+	specFilePath := ctx.GetStringFlagValue(commands.SpecFlag)
+	content, err := ReadSpecFile(ctx, specFilePath)
+	if err != nil {
+		return err
+	}
+	return DecodeSpec(specFilePath, content, spec)
+}
+
+// ReadSpecFile returns the content of the spec file at specFilePath, with the variables of the --spec-vars flag replaced.
+func ReadSpecFile(ctx *components.Context, specFilePath string) ([]byte, error) {
+	content, err := fileutils.ReadFile(specFilePath)
+	if errorutils.CheckError(err) != nil {
+		return nil, err
+	}
+
+	specVars := coreutils.SpecVarsStringToMap(ctx.GetStringFlagValue(commands.SpecVarsFlag))
+	if len(specVars) > 0 {
+		content = coreutils.ReplaceVars(content, specVars)
+	}
+	return content, nil
+}
+
+// DecodeSpec decodes the content of the spec file at specFilePath into spec, a pointer to a struct with JSON tags.
+// Specs with the .yaml or .yml extension are YAML, and specs with the .json extension are JSON. The format of
+// other specs is detected from their content: JSON specs start with '{' or '['.
+// Errors point to the line and column of the spec where decoding failed.
+func DecodeSpec(specFilePath string, content []byte, spec interface{}) error {
+	if isYamlSpec(specFilePath, content) {
+		return decodeYamlSpec(specFilePath, content, spec)
+	}
+	return decodeJsonSpec(specFilePath, content, spec)
+}
+
+func isYamlSpec(specFilePath string, content []byte) bool {
+	switch strings.ToLower(filepath.Ext(specFilePath)) {
+	case ".yaml", ".yml":
+		return true
+	case ".json":
+		return false
+	}
+	trimmed := bytes.TrimSpace(content)
+	return len(trimmed) > 0 && trimmed[0] != '{' && trimmed[0] != '['
+}
+
+func decodeJsonSpec(specFilePath string, content []byte, spec interface{}) error {
+	err := json.Unmarshal(content, spec)
+	if err == nil {
+		return nil
+	}
+	// The errors of encoding/json occur after reading Offset bytes, so the offending byte is the last one read.
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		line, column := positionOfOffset(content, syntaxErr.Offset-1)
+		return specError(specFilePath, line, column, syntaxErr.Error())
+	case errors.As(err, &typeErr):
+		line, column := positionOfOffset(content, typeErr.Offset-1)
+		return specError(specFilePath, line, column, describeTypeError(typeErr))
+	default:
+		return errorutils.CheckErrorf("invalid spec file '%s': %s", specFilePath, err.Error())
+	}
+}
+
+// positionOfOffset returns the line and column, counted from 1, of the byte at offset.
+func positionOfOffset(content []byte, offset int64) (line, column int) {
+	offset = max(0, min(offset, int64(len(content))))
+	before := content[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	column = int(offset) - (bytes.LastIndexByte(before, '\n') + 1) + 1
+	return line, column
+}
+
+// ParseSpec parses the content of the spec file at specFilePath into a tree of YAML nodes, which hold the line and
+// column of each value, whether the spec is YAML or JSON. See DecodeSpec for the supported formats.
+// The document node of an empty YAML spec has no content.
+func ParseSpec(specFilePath string, content []byte) (*yaml.Node, error) {
+	if isYamlSpec(specFilePath, content) {
+		return parseYamlSpec(specFilePath, content)
+	}
+	// Decoding reports the syntax errors with their position.
+	var value interface{}
+	if err := decodeJsonSpec(specFilePath, content, &value); err != nil {
+		return nil, err
+	}
+	parser := &jsonNodeParser{content: content, decoder: json.NewDecoder(bytes.NewReader(content))}
+	parser.decoder.UseNumber()
+	node, err := parser.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Line: node.Line, Column: node.Column, Content: []*yaml.Node{node}}, nil
+}
+
+// ParseSpecDocuments parses the documents of a YAML spec file, separated by '---' lines, like ParseSpec.
+// Empty documents, such as the one after a trailing '---' line, are skipped. A JSON spec file has a single
+// document. An empty spec has a single document node without content.
+func ParseSpecDocuments(specFilePath string, content []byte) ([]*yaml.Node, error) {
+	if !isYamlSpec(specFilePath, content) {
+		document, err := ParseSpec(specFilePath, content)
+		if err != nil {
+			return nil, err
+		}
+		return []*yaml.Node{document}, nil
+	}
+
+	var documents []*yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		document := new(yaml.Node)
+		err := decoder.Decode(document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, yamlSyntaxError(specFilePath, err)
+		}
+		if root := document.Content[0]; root.Kind == yaml.ScalarNode && root.ShortTag() == "!!null" && root.Value == "" {
+			continue
+		}
+		documents = append(documents, document)
+	}
+	if len(documents) == 0 {
+		documents = append(documents, new(yaml.Node))
+	}
+	return documents, nil
+}
+
+func parseYamlSpec(specFilePath string, content []byte) (*yaml.Node, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, yamlSyntaxError(specFilePath, err)
+	}
+	return &document, nil
+}
+
+// yamlSyntaxError returns the error of a YAML syntax error, which states the line, e.g.,
+// "yaml: line 3: mapping values are not allowed in this context".
+func yamlSyntaxError(specFilePath string, err error) error {
+	return errorutils.CheckErrorf("invalid spec file '%s': %s", specFilePath, strings.TrimPrefix(err.Error(), "yaml: "))
+}
+
+func decodeYamlSpec(specFilePath string, content []byte, spec interface{}) error {
+	document, err := parseYamlSpec(specFilePath, content)
+	if err != nil {
+		return err
+	}
+	if document.Kind == 0 {
+		// An empty spec.
+		return nil
+	}
+	return DecodeSpecNode(specFilePath, document, spec)
+}
+
+// DecodeSpecNode decodes a node of the spec file at specFilePath, as returned by ParseSpec, into spec, a pointer
+// to a struct with JSON tags. Errors point to the line and column of the spec where decoding failed.
+func DecodeSpecNode(specFilePath string, node *yaml.Node, spec interface{}) error {
+	// The node is converted to JSON, so that the spec is decoded by its JSON tags.
+	// The position of each value in the JSON is recorded, to report the position of type errors in the spec.
+	converter := &yamlToJsonConverter{}
+	if err := converter.convert(node); err != nil {
+		var nodeErr *yamlNodeError
+		if errors.As(err, &nodeErr) {
+			return specError(specFilePath, nodeErr.node.Line, nodeErr.node.Column, nodeErr.message)
+		}
+		return errorutils.CheckError(err)
+	}
+	err := json.Unmarshal(converter.buffer.Bytes(), spec)
+	if err == nil {
+		return nil
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		if node := converter.nodeAt(typeErr.Offset); node != nil {
+			return specError(specFilePath, node.Line, node.Column, describeTypeError(typeErr))
+		}
+	}
+	return errorutils.CheckErrorf("invalid spec file '%s': %s", specFilePath, err.Error())
+}
+
+func specError(specFilePath string, line, column int, message string) error {
+	return errorutils.CheckErrorf("invalid spec file '%s': line %d, column %d: %s", specFilePath, line, column, message)
+}
+
+func describeTypeError(err *json.UnmarshalTypeError) string {
+	if err.Field == "" {
+		return fmt.Sprintf("expected %s, found %s", jsonTypeName(err.Type), err.Value)
+	}
+	return fmt.Sprintf("'%s' must be %s, found %s", err.Field, jsonTypeName(err.Type), err.Value)
+}
+
+// jsonTypeName names the JSON type that decodes into t.
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	case reflect.Pointer:
+		return jsonTypeName(t.Elem())
+	default:
+		return t.String()
+	}
+}
+
+// yamlNodeError is an error in a YAML value that has no JSON equivalent.
+type yamlNodeError struct {
+	node    *yaml.Node
+	message string
+}
+
+func (e *yamlNodeError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.node.Line, e.node.Column, e.message)
+}
+
+// yamlToJsonConverter writes a YAML document as JSON, and records the range of each value in the JSON.
+type yamlToJsonConverter struct {
+	buffer bytes.Buffer
+	ranges []nodeRange
+}
+
+type nodeRange struct {
+	start, end int64
+	node       *yaml.Node
+}
+
+// nodeAt returns the innermost node whose JSON value contains offset, the position after which
+// encoding/json reports an error.
+func (c *yamlToJsonConverter) nodeAt(offset int64) *yaml.Node {
+	var found *nodeRange
+	for i := range c.ranges {
+		r := &c.ranges[i]
+		if r.start < offset && offset <= r.end && (found == nil || r.end-r.start < found.end-found.start) {
+			found = r
+		}
+	}
+	if found == nil {
+		return nil
+	}
+	return found.node
+}
+
+func (c *yamlToJsonConverter) convert(node *yaml.Node) error {
+	start := int64(c.buffer.Len())
+	if err := c.write(node); err != nil {
+		return err
+	}
+	c.ranges = append(c.ranges, nodeRange{start: start, end: int64(c.buffer.Len()), node: node})
+	return nil
+}
+
+func (c *yamlToJsonConverter) write(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return c.convert(node.Content[0])
+	case yaml.AliasNode:
+		return c.convert(node.Alias)
+	case yaml.MappingNode:
+		c.buffer.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Kind != yaml.ScalarNode {
+				return &yamlNodeError{node: key, message: "keys must be strings"}
+			}
+			if i > 0 {
+				c.buffer.WriteByte(',')
+			}
+			if err := c.writeString(key.Value); err != nil {
+				return err
+			}
+			c.buffer.WriteByte(':')
+			if err := c.convert(value); err != nil {
+				return err
+			}
+		}
+		c.buffer.WriteByte('}')
+	case yaml.SequenceNode:
+		c.buffer.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				c.buffer.WriteByte(',')
+			}
+			if err := c.convert(item); err != nil {
+				return err
+			}
+		}
+		c.buffer.WriteByte(']')
+	default:
+		if node.ShortTag() == "!!timestamp" {
+			// Dates are kept as written, rather than converted to time.Time and formatted in RFC 3339.
+			return c.writeString(node.Value)
+		}
+		var value interface{}
+		if err := node.Decode(&value); err != nil {
+			return &yamlNodeError{node: node, message: err.Error()}
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return &yamlNodeError{node: node, message: fmt.Sprintf("unsupported value '%s'", node.Value)}
+		}
+		c.buffer.Write(encoded)
+	}
+	return nil
+}
+
+func (c *yamlToJsonConverter) writeString(value string) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	c.buffer.Write(encoded)
+	return nil
+}
+
+// jsonNodeParser parses a valid JSON document into YAML nodes, with the line and column of each value.
+type jsonNodeParser struct {
+	content []byte
+	decoder *json.Decoder
+}
+
+func (p *jsonNodeParser) parse() (*yaml.Node, error) {
+	line, column := positionOfOffset(p.content, p.valueOffset())
+	token, err := p.decoder.Token()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	node := &yaml.Node{Kind: yaml.ScalarNode, Line: line, Column: column}
+	switch value := token.(type) {
+	case json.Delim:
+		node.Kind, node.Tag = yaml.MappingNode, "!!map"
+		if value == '[' {
+			node.Kind, node.Tag = yaml.SequenceNode, "!!seq"
+		}
+		// Object keys are parsed as string values.
+		for p.decoder.More() {
+			child, err := p.parse()
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, child)
+		}
+		// The closing delimiter.
+		if _, err = p.decoder.Token(); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	case string:
+		node.Tag, node.Value, node.Style = "!!str", value, yaml.DoubleQuotedStyle
+	case json.Number:
+		node.Tag, node.Value = "!!int", value.String()
+		if strings.ContainsAny(node.Value, ".eE") {
+			node.Tag = "!!float"
+		}
+	case bool:
+		node.Tag, node.Value = "!!bool", strconv.FormatBool(value)
+	default:
+		node.Tag, node.Value = "!!null", "null"
+	}
+	return node, nil
+}
+
+// valueOffset returns the offset of the next value. The offset of the decoder precedes the whitespace and the
+// separators before the value.
+func (p *jsonNodeParser) valueOffset() int64 {
+	offset := p.decoder.InputOffset()
+	for offset < int64(len(p.content)) && strings.IndexByte(" \t\r\n,:", p.content[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
@@ -0,0 +1,241 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type testSpec struct {
+	Name    string            `json:"name"`
+	Count   int               `json:"count"`
+	Enabled bool              `json:"enabled"`
+	Tags    []string          `json:"tags"`
+	Labels  map[string]string `json:"labels"`
+}
+
+func TestDecodeSpec(t *testing.T) {
+	expected := testSpec{Name: "app", Count: 2, Enabled: true, Tags: []string{"a", "b"}, Labels: map[string]string{"env": "prod"}}
+
+	tests := []struct {
+		name         string
+		specFilePath string
+		content      string
+	}{
+		{
+			name:         "JSON",
+			specFilePath: "spec.json",
+			content:      `{"name": "app", "count": 2, "enabled": true, "tags": ["a", "b"], "labels": {"env": "prod"}}`,
+		},
+		{
+			name:         "YAML",
+			specFilePath: "spec.yaml",
+			content:      "name: app\ncount: 2\nenabled: true\ntags:\n  - a\n  - b\nlabels:\n  env: prod\n",
+		},
+		{
+			name:         "YAML with the .yml extension",
+			specFilePath: "spec.yml",
+			content:      "name: app\ncount: 2\nenabled: true\ntags: [a, b]\nlabels: {env: prod}\n",
+		},
+		{
+			name:         "JSON detected by content",
+			specFilePath: "spec",
+			content:      `  {"name": "app", "count": 2, "enabled": true, "tags": ["a", "b"], "labels": {"env": "prod"}}`,
+		},
+		{
+			name:         "YAML detected by content",
+			specFilePath: "spec.txt",
+			content:      "# The spec of the app.\nname: app\ncount: 2\nenabled: true\ntags: [a, b]\nlabels:\n  env: prod\n",
+		},
+		{
+			name:         "YAML with an anchor",
+			specFilePath: "spec.yaml",
+			content:      "name: &name app\ncount: 2\nenabled: true\ntags: [a, b]\nlabels:\n  env: prod\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spec testSpec
+			require.NoError(t, DecodeSpec(tt.specFilePath, []byte(tt.content), &spec))
+			assert.Equal(t, expected, spec)
+		})
+	}
+}
+
+func TestDecodeSpec_EmptyYaml(t *testing.T) {
+	var spec testSpec
+	require.NoError(t, DecodeSpec("spec.yaml", []byte("# Nothing yet.\n"), &spec))
+	assert.Equal(t, testSpec{}, spec)
+}
+
+func TestDecodeSpec_YamlTimestamp(t *testing.T) {
+	var spec testSpec
+	require.NoError(t, DecodeSpec("spec.yaml", []byte("name: 2024-01-31\n"), &spec))
+	assert.Equal(t, "2024-01-31", spec.Name)
+}
+
+func TestDecodeSpec_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		specFilePath  string
+		content       string
+		expectedError string
+	}{
+		{
+			name:          "JSON syntax error",
+			specFilePath:  "spec.json",
+			content:       "{\n  \"name\": \"app\",\n  \"count\": 2,,\n}",
+			expectedError: "invalid spec file 'spec.json': line 3, column 14: invalid character ',' looking for beginning of object key string",
+		},
+		{
+			name:          "JSON type error",
+			specFilePath:  "spec.json",
+			content:       "{\n  \"name\": \"app\",\n  \"count\": \"two\"\n}",
+			expectedError: "invalid spec file 'spec.json': line 3, column 16: 'count' must be a number, found string",
+		},
+		{
+			name:          "JSON truncated",
+			specFilePath:  "spec.json",
+			content:       "{\n  \"name\": \"app\"",
+			expectedError: "invalid spec file 'spec.json': line 2, column 15: unexpected end of JSON input",
+		},
+		{
+			name:          "YAML syntax error",
+			specFilePath:  "spec.yaml",
+			content:       "name: app\ncount: 2\nlabels:\n  env: prod: eu\n",
+			expectedError: "invalid spec file 'spec.yaml': line 4: mapping values are not allowed in this context",
+		},
+		{
+			name:          "YAML type error",
+			specFilePath:  "spec.yaml",
+			content:       "name: app\ncount: 2\nenabled: yes-please\n",
+			expectedError: "invalid spec file 'spec.yaml': line 3, column 10: 'enabled' must be a boolean, found string",
+		},
+		{
+			name:          "YAML type error in a list",
+			specFilePath:  "spec.yaml",
+			content:       "name: app\ntags:\n  - a\n  - key: value\n",
+			expectedError: "invalid spec file 'spec.yaml': line 4, column 5: 'tags.1' must be a string, found object",
+		},
+		{
+			name:          "YAML key that isn't a scalar",
+			specFilePath:  "spec.yaml",
+			content:       "name: app\n? [a, b]\n: value\n",
+			expectedError: "invalid spec file 'spec.yaml': line 2, column 3: keys must be strings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spec testSpec
+			err := DecodeSpec(tt.specFilePath, []byte(tt.content), &spec)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestLoadSpecFile_SpecVars(t *testing.T) {
+	specFilePath := filepath.Join(t.TempDir(), "spec.yaml")
+	require.NoError(t, os.WriteFile(specFilePath, []byte("name: ${NAME}\ncount: ${COUNT}\n"), 0600))
+
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.SpecFlag, specFilePath)
+	ctx.AddStringFlag(commands.SpecVarsFlag, "NAME=app;COUNT=3")
+
+	var spec testSpec
+	require.NoError(t, LoadSpecFile(ctx, &spec))
+	assert.Equal(t, testSpec{Name: "app", Count: 3}, spec)
+}
+
+func TestParseSpec(t *testing.T) {
+	tests := []struct {
+		name         string
+		specFilePath string
+		content      string
+	}{
+		{"JSON", "spec.json", "{\n  \"name\": \"app\",\n  \"tags\": [\"a\", 1, true, null]\n}"},
+		{"YAML", "spec.yaml", "name: app\ntags: [a, 1, true, null]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := ParseSpec(tt.specFilePath, []byte(tt.content))
+			require.NoError(t, err)
+			require.Len(t, document.Content, 1)
+			root := document.Content[0]
+			require.Equal(t, yaml.MappingNode, root.Kind)
+			require.Len(t, root.Content, 4)
+
+			name, tags := root.Content[1], root.Content[3]
+			assert.Equal(t, "app", name.Value)
+			assert.Equal(t, "!!str", name.ShortTag())
+			require.Equal(t, yaml.SequenceNode, tags.Kind)
+			var shortTags []string
+			for _, item := range tags.Content {
+				shortTags = append(shortTags, item.ShortTag())
+			}
+			assert.Equal(t, []string{"!!str", "!!int", "!!bool", "!!null"}, shortTags)
+		})
+	}
+
+	// The positions of JSON values.
+	document, err := ParseSpec("spec.json", []byte("{\n  \"name\": \"app\",\n  \"tags\": [\"a\", 1.5]\n}"))
+	require.NoError(t, err)
+	root := document.Content[0]
+	assert.Equal(t, []int{1, 1}, []int{root.Line, root.Column})
+	assert.Equal(t, []int{2, 3}, []int{root.Content[0].Line, root.Content[0].Column})
+	assert.Equal(t, []int{2, 11}, []int{root.Content[1].Line, root.Content[1].Column})
+	item := root.Content[3].Content[1]
+	assert.Equal(t, []int{3, 17}, []int{item.Line, item.Column})
+	assert.Equal(t, "!!float", item.ShortTag())
+}
+
+func TestParseSpec_SyntaxError(t *testing.T) {
+	_, err := ParseSpec("spec.json", []byte("{\"name\": }"))
+	assert.EqualError(t, err, "invalid spec file 'spec.json': line 1, column 10: invalid character '}' looking for beginning of value")
+}
+
+func TestParseSpecDocuments(t *testing.T) {
+	tests := []struct {
+		name          string
+		specFilePath  string
+		content       string
+		expectedNames []string
+	}{
+		{"YAML documents", "specs.yaml", "---\nname: a\n---\nname: b\n---\n", []string{"a", "b"}},
+		{"JSON", "spec.json", `{"name": "a"}`, []string{"a"}},
+		{"empty YAML", "spec.yaml", "# Nothing yet.\n", []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documents, err := ParseSpecDocuments(tt.specFilePath, []byte(tt.content))
+			require.NoError(t, err)
+			var names []string
+			for _, document := range documents {
+				var spec testSpec
+				if len(document.Content) > 0 {
+					require.NoError(t, DecodeSpecNode(tt.specFilePath, document, &spec))
+				}
+				names = append(names, spec.Name)
+			}
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestDecodeSpecNode_TypeError(t *testing.T) {
+	documents, err := ParseSpecDocuments("specs.yaml", []byte("name: a\n---\nname: [b]\n"))
+	require.NoError(t, err)
+	require.Len(t, documents, 2)
+	var spec testSpec
+	err = DecodeSpecNode("specs.yaml", documents[1], &spec)
+	assert.EqualError(t, err, "invalid spec file 'specs.yaml': line 3, column 7: 'name' must be a string, found array")
+}
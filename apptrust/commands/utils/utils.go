@@ -1,17 +1,27 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/oidc"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 const (
@@ -34,6 +44,9 @@ func ServerDetailsByFlags(ctx *components.Context) (*coreConfig.ServerDetails, e
 	if serverDetails.Url == "" {
 		return nil, fmt.Errorf("platform URL is mandatory for AppTrust commands")
 	}
+	if err = applyAccessTokenChain(ctx, serverDetails); err != nil {
+		return nil, err
+	}
 	if serverDetails.GetUser() != "" && serverDetails.GetPassword() != "" {
 		return nil, fmt.Errorf("AppTrust service does not support basic authentication")
 	}
@@ -41,6 +54,101 @@ func ServerDetailsByFlags(ctx *components.Context) (*coreConfig.ServerDetails, e
 	return serverDetails, nil
 }
 
+// ClientOptionsByFlags returns the HTTP client options set by the flags shared by all commands.
+// A flag that is not set falls back to its environment variable, and then to the client default.
+// The options include a context that is canceled when the --timeout flag elapses or when the user presses Ctrl-C,
+// the token source of the --oidc-provider flag, if it is set, and the plan mode of the --plan flag. In plan mode,
+// the credential helper of the access token chain is run by a token source, only if a request is sent.
+// The returned release function must be called when the command completes.
+func ClientOptionsByFlags(ctx *components.Context) (options []http.ClientOption, release func(), err error) {
+	tokenSource, err := OidcTokenSourceByFlags(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ClientOptionsWithTokenSource(ctx, tokenSource)
+}
+
+// ClientOptionsWithTokenSource is like ClientOptionsByFlags, but uses the token source of OidcTokenSourceByFlags
+// that the command already built, so that the ID token is read once. tokenSource may be nil.
+func ClientOptionsWithTokenSource(ctx *components.Context, tokenSource *oidc.TokenSource) (options []http.ClientOption, release func(), err error) {
+	if value, source := flagOrEnvValue(ctx, commands.RetriesFlag, commands.RetriesEnv); value != "" {
+		retries, err := strconv.Atoi(value)
+		if err != nil || retries < 0 {
+			return nil, nil, errorutils.CheckErrorf("invalid value for %s: '%s'. Expected a non-negative number", source, value)
+		}
+		options = append(options, http.WithRetries(retries))
+	}
+
+	if value, source := flagOrEnvValue(ctx, commands.RetryWaitFlag, commands.RetryWaitEnv); value != "" {
+		retryWait, err := time.ParseDuration(value)
+		if err != nil || retryWait <= 0 {
+			return nil, nil, errorutils.CheckErrorf("invalid value for %s: '%s'. Expected a positive duration (e.g., 500ms, 2s)", source, value)
+		}
+		options = append(options, http.WithRetryWait(retryWait))
+	}
+
+	var timeout time.Duration
+	if value := ctx.GetStringFlagValue(commands.TimeoutFlag); value != "" {
+		if timeout, err = ParseDurationFlag(commands.TimeoutFlag, value); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if tokenSource != nil {
+		options = append(options, http.WithTokenSource(tokenSource))
+	}
+
+	if ctx.GetBoolFlagValue(commands.PlanFlag) {
+		options = append(options, http.WithPlan())
+		if helperTokenSource := credentialHelperTokenSourceByFlags(ctx); helperTokenSource != nil {
+			options = append(options, http.WithTokenSource(helperTokenSource))
+		}
+	}
+
+	var tracer *http.Tracer
+	if tracePath := ctx.GetStringFlagValue(commands.TraceHttpFlag); tracePath != "" {
+		if tracer, err = http.NewFileTracer(tracePath, ParseSliceFlag(ctx.GetStringFlagValue(commands.TraceHttpRedactFlag))); err != nil {
+			return nil, nil, err
+		}
+		options = append(options, http.WithTracer(tracer))
+	}
+
+	commandCtx, cancel := NewCommandContext(timeout)
+	release = func() {
+		cancel()
+		if tracer == nil {
+			return
+		}
+		if err := tracer.Close(); err != nil {
+			log.Warn("Failed to write the HTTP trace:", err.Error())
+		}
+	}
+	return append(options, http.WithContext(commandCtx)), release, nil
+}
+
+// ExecCommand runs the command and reports its usage. With the --plan flag, the usage isn't reported, since
+// the report is sent to the server, and the request planned by the command is printed in the format of the --format flag.
+func ExecCommand(ctx *components.Context, command commonCLiCommands.Command) error {
+	if !ctx.GetBoolFlagValue(commands.PlanFlag) {
+		return commonCLiCommands.Exec(command)
+	}
+	err := command.Run()
+	var plannedErr *http.PlannedError
+	if errors.As(err, &plannedErr) {
+		return output.Print(ctx.GetStringFlagValue(commands.FormatFlag), plannedErr.Request)
+	}
+	return err
+}
+
+// flagOrEnvValue returns the value of the flag, or of the environment variable if the flag is not set,
+// along with the name of its source for error messages.
+func flagOrEnvValue(ctx *components.Context, flagName, envName string) (value, source string) {
+	if value = ctx.GetStringFlagValue(flagName); value != "" {
+		return value, "--" + flagName
+	}
+	return os.Getenv(envName), envName
+}
+
 // ParseSliceFlag parses a comma-separated string into a slice of strings.
 func ParseSliceFlag(flagValue string) []string {
 	if flagValue == "" {
@@ -92,6 +200,44 @@ func ValidateEnumFlag(flagName, value string, defaultValue string, allowedValues
 		flagName, value, coreutils.ListToText(allowedValues))
 }
 
+// ParseTimeFlag parses a time flag value given either as a date (YYYY-MM-DD) or in RFC 3339 format.
+// If the value is empty, returns the zero time.
+func ParseTimeFlag(flagName, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(time.DateOnly, value); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected a date (YYYY-MM-DD) or an RFC 3339 time", flagName, value)
+}
+
+// ParseDurationFlag parses a duration flag value such as "30s" or "5m".
+// Returns an error if the value is not a positive duration.
+func ParseDurationFlag(flagName, value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected a positive duration (e.g., 30s, 5m, 1h)", flagName, value)
+	}
+	return duration, nil
+}
+
+// ParseLimitFlag parses the value of a flag that caps the number of returned items.
+// If the value is empty, returns zero, which means no limit. Returns an error if the value is not a positive number.
+func ParseLimitFlag(flagName, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, errorutils.CheckErrorf("invalid value for --%s: '%s'. Expected a positive number", flagName, value)
+	}
+	return limit, nil
+}
+
 // ParseDelimitedSlice splits a delimited string into a slice of string slices.
 // Example: input "a:1;b:2" returns [][]string{{"a","1"},{"b","2"}}
 func ParseDelimitedSlice(input string) [][]string {
@@ -1,11 +1,20 @@
 package utils
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseSliceFlag(t *testing.T) {
@@ -274,3 +283,213 @@ func TestParseLabelKeyValuePairs(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTimeFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  time.Time
+		expectErr bool
+	}{
+		{"empty string", "", time.Time{}, false},
+		{"date only", "2025-03-01", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"rfc3339", "2025-03-01T10:20:30Z", time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC), false},
+		{"invalid", "yesterday", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseTimeFlag("created-after", tt.input)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid value for --created-after")
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "ParseTimeFlag(%q) = %v, want %v", tt.input, result, tt.expected)
+		})
+	}
+}
+
+func TestParseDurationFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{"seconds", "30s", 30 * time.Second, false},
+		{"minutes", "5m", 5 * time.Minute, false},
+		{"empty string", "", 0, true},
+		{"negative", "-1s", 0, true},
+		{"no unit", "10", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDurationFlag("timeout", tt.input)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid value for --timeout")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseLimitFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  int
+		expectErr bool
+	}{
+		{"empty string", "", 0, false},
+		{"positive", "25", 25, false},
+		{"zero", "0", 0, true},
+		{"negative", "-1", 0, true},
+		{"not a number", "ten", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseLimitFlag("limit", tt.input)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid value for --limit")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestClientOptionsByFlags(t *testing.T) {
+	tests := []struct {
+		name            string
+		flags           map[string]string
+		env             map[string]string
+		expectedOptions int
+		expectedError   string
+	}{
+		{
+			name:            "defaults",
+			expectedOptions: 1,
+		},
+		{
+			name:            "flags",
+			flags:           map[string]string{commands.RetriesFlag: "5", commands.RetryWaitFlag: "500ms", commands.TimeoutFlag: "5m"},
+			expectedOptions: 3,
+		},
+		{
+			name:            "environment variables",
+			env:             map[string]string{commands.RetriesEnv: "0", commands.RetryWaitEnv: "2s"},
+			expectedOptions: 3,
+		},
+		{
+			name:          "flag takes precedence over environment variable",
+			flags:         map[string]string{commands.RetriesFlag: "-1"},
+			env:           map[string]string{commands.RetriesEnv: "2"},
+			expectedError: "invalid value for --retries: '-1'",
+		},
+		{
+			name:          "invalid environment variable",
+			env:           map[string]string{commands.RetryWaitEnv: "2"},
+			expectedError: "invalid value for JFROG_APPTRUST_RETRY_WAIT: '2'",
+		},
+		{
+			name:          "invalid timeout",
+			flags:         map[string]string{commands.TimeoutFlag: "soon"},
+			expectedError: "invalid value for --timeout: 'soon'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(commands.RetriesEnv, "")
+			t.Setenv(commands.RetryWaitEnv, "")
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			ctx := &components.Context{}
+			for name, value := range tt.flags {
+				ctx.AddStringFlag(name, value)
+			}
+
+			options, release, err := ClientOptionsByFlags(ctx)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			defer release()
+			assert.Len(t, options, tt.expectedOptions)
+		})
+	}
+}
+
+func TestClientOptionsByFlags_TraceHttp(t *testing.T) {
+	tracePath := filepath.Join(t.TempDir(), "trace.har")
+	ctx := &components.Context{}
+	ctx.AddStringFlag(commands.TraceHttpFlag, tracePath)
+	ctx.AddStringFlag(commands.TraceHttpRedactFlag, "license; owner")
+
+	options, release, err := ClientOptionsByFlags(ctx)
+	require.NoError(t, err)
+	assert.Len(t, options, 2)
+	release()
+
+	content, err := os.ReadFile(tracePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"entries":[]`)
+
+	ctx = &components.Context{}
+	ctx.AddStringFlag(commands.TraceHttpFlag, filepath.Join(tracePath, "missing", "trace.har"))
+	_, _, err = ClientOptionsByFlags(ctx)
+	assert.ErrorContains(t, err, "failed to create the HTTP trace file")
+}
+
+type stubCommand struct {
+	err  error
+	runs int
+}
+
+func (c *stubCommand) Run() error {
+	c.runs++
+	return c.err
+}
+
+func (c *stubCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return nil, nil
+}
+
+func (c *stubCommand) CommandName() string {
+	return "stub"
+}
+
+func TestExecCommand_Plan(t *testing.T) {
+	plannedErr := &http.PlannedError{Request: http.PlannedRequest{Method: "DELETE", Endpoint: "https://acme.jfrog.io/apptrust/api/v1/applications/app"}}
+	tests := []struct {
+		name          string
+		plan          bool
+		err           error
+		expectedError error
+	}{
+		{name: "planned request", plan: true, err: plannedErr},
+		{name: "error before the request", plan: true, err: errors.New("invalid spec"), expectedError: errors.New("invalid spec")},
+		{name: "planned request without --plan", err: plannedErr, expectedError: plannedErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{}
+			ctx.AddBoolFlag(commands.PlanFlag, tt.plan)
+			command := &stubCommand{err: tt.err}
+
+			err := ExecCommand(ctx, command)
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, 1, command.runs)
+		})
+	}
+}
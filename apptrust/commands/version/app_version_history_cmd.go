@@ -0,0 +1,134 @@
+package version
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type appVersionHistoryCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
+	applicationKey string
+	version        string
+	format         string
+}
+
+// versionHistoryRow is a single row of the version history table.
+type versionHistoryRow struct {
+	Created       string `col-name:"Timestamp"`
+	EventType     string `col-name:"Event"`
+	SourceStage   string `col-name:"Source Stage"`
+	TargetStage   string `col-name:"Target Stage"`
+	PromotionType string `col-name:"Promotion Type"`
+	CreatedBy     string `col-name:"Actor"`
+	Status        string `col-name:"Outcome"`
+}
+
+func (vh *appVersionHistoryCommand) Run() error {
+	ctx, err := service.NewContext(*vh.serverDetails, vh.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	events, err := vh.versionService.GetAppVersionHistory(ctx, vh.applicationKey, vh.version)
+	if err != nil {
+		return err
+	}
+
+	return output.PrintWithTable(vh.format, events, toVersionHistoryRows(events), "No history was found for this version")
+}
+
+func (vh *appVersionHistoryCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return vh.serverDetails, nil
+}
+
+func (vh *appVersionHistoryCommand) CommandName() string {
+	return commands.VersionHistory
+}
+
+func (vh *appVersionHistoryCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	vh.applicationKey = ctx.Arguments[0]
+	vh.version = ctx.Arguments[1]
+
+	vh.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(vh.format); err != nil {
+		return err
+	}
+
+	var err error
+	vh.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	vh.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(vh)
+}
+
+func toVersionHistoryRows(events []model.VersionHistoryEvent) []versionHistoryRow {
+	rows := make([]versionHistoryRow, 0, len(events))
+	for _, event := range events {
+		status := event.Status
+		// Surface the failure reason next to the outcome.
+		if len(event.Messages) > 0 {
+			status += ": " + strings.Join(event.Messages, "; ")
+		}
+		rows = append(rows, versionHistoryRow{
+			Created:       event.Created,
+			EventType:     event.EventType,
+			SourceStage:   event.SourceStage,
+			TargetStage:   event.TargetStage,
+			PromotionType: event.PromotionType,
+			CreatedBy:     event.CreatedBy,
+			Status:        status,
+		})
+	}
+	return rows
+}
+
+func GetAppVersionHistoryCommand(appContext app.Context) components.Command {
+	cmd := &appVersionHistoryCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionHistory,
+		Description: "Show the promotion, release and rollback history of an application version.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vh"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to show the history of.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionHistory),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
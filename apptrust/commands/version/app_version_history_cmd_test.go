@@ -0,0 +1,71 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAppVersionHistoryCommand_Run(t *testing.T) {
+	events := []model.VersionHistoryEvent{
+		{EventType: model.VersionEventPromotion, SourceStage: "DEV", TargetStage: "QA", PromotionType: "copy", Status: "COMPLETED", CreatedBy: "admin", Created: "2025-01-02T10:00:00Z"},
+		{EventType: model.VersionEventRollback, SourceStage: "QA", TargetStage: "DEV", Status: "COMPLETED", Created: "2025-01-03T10:00:00Z"},
+	}
+
+	for _, format := range output.FormatValues {
+		t.Run(format, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.0.0").
+				Return(events, nil).Times(1)
+
+			cmd := &appVersionHistoryCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				applicationKey: "app-key",
+				version:        "1.0.0",
+				format:         format,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAppVersionHistoryCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersionHistory(gomock.Any(), "app-key", "1.0.0").
+		Return(nil, errors.New("service error occurred")).Times(1)
+
+	cmd := &appVersionHistoryCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		format:         output.FormatTable,
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "service error occurred")
+}
+
+func TestToVersionHistoryRows(t *testing.T) {
+	rows := toVersionHistoryRows([]model.VersionHistoryEvent{
+		{EventType: model.VersionEventRelease, SourceStage: "QA", TargetStage: "PROD", PromotionType: "move", Status: "FAILED", CreatedBy: "admin", Created: "2025-01-02T10:00:00Z", Messages: []string{"first", "second"}},
+	})
+	assert.Equal(t, []versionHistoryRow{
+		{Created: "2025-01-02T10:00:00Z", EventType: model.VersionEventRelease, SourceStage: "QA", TargetStage: "PROD", PromotionType: "move", CreatedBy: "admin", Status: "FAILED: first; second"},
+	}, rows)
+}
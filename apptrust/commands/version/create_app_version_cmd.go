@@ -1,42 +1,64 @@
 package version
 
 import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type createAppVersionCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	requestPayload *model.CreateAppVersionRequest
 	sync           bool
 	dryRun         bool
+	format         string
 }
 
 func (cv *createAppVersionCommand) Run() error {
-	ctx, err := service.NewContext(*cv.serverDetails)
+	ctx, err := service.NewContext(*cv.serverDetails, cv.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return cv.versionService.CreateAppVersion(ctx, cv.requestPayload, cv.sync, cv.dryRun)
+	appVersion, err := cv.versionService.CreateAppVersion(ctx, cv.requestPayload, cv.sync, cv.dryRun)
+	if err != nil {
+		return withVersionGetHint(err)
+	}
+	cv.logSuccessMessage()
+	return output.Print(cv.format, appVersion)
 }
 
 func (cv *createAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
 	return cv.serverDetails, nil
 }
 
+func (cv *createAppVersionCommand) logSuccessMessage() {
+	switch {
+	case !cv.sync:
+		log.Info(fmt.Sprintf("Application version creation initiated: %s:%s", cv.requestPayload.ApplicationKey, cv.requestPayload.Version))
+	case cv.dryRun:
+		log.Info(fmt.Sprintf("Dry run successful for application version: %s:%s", cv.requestPayload.ApplicationKey, cv.requestPayload.Version))
+	default:
+		log.Info(fmt.Sprintf("Application version created successfully: %s:%s", cv.requestPayload.ApplicationKey, cv.requestPayload.Version))
+	}
+}
+
 func (cv *createAppVersionCommand) CommandName() string {
 	return commands.VersionCreate
 }
@@ -45,18 +67,29 @@ func (cv *createAppVersionCommand) prepareAndRunCommand(ctx *components.Context)
 	if err := validateCreateAppVersionContext(ctx); err != nil {
 		return err
 	}
+
+	cv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(cv.format); err != nil {
+		return err
+	}
 	serverDetails, err := utils.ServerDetailsByFlags(ctx)
 	if err != nil {
 		return err
 	}
 	cv.serverDetails = serverDetails
+	var release func()
+	cv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 	cv.sync = ctx.GetBoolTFlagValue(commands.SyncFlag)
 	cv.requestPayload, err = cv.buildRequestPayload(ctx)
 	if errorutils.CheckError(err) != nil {
 		return err
 	}
 	cv.dryRun = ctx.GetBoolFlagValue(commands.DryRunFlag)
-	return commonCLiCommands.Exec(cv)
+	return utils.ExecCommand(ctx, cv)
 }
 
 func (cv *createAppVersionCommand) buildRequestPayload(ctx *components.Context) (*model.CreateAppVersionRequest, error) {
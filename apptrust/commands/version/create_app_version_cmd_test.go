@@ -77,10 +77,10 @@ func TestCreateAppVersionCommand(t *testing.T) {
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			if tt.shouldError {
 				mockVersionService.EXPECT().CreateAppVersion(gomock.Any(), tt.request, true, tt.dryRun).
-					Return(errors.New(tt.errorMessage)).Times(1)
+					Return(nil, errors.New(tt.errorMessage)).Times(1)
 			} else {
 				mockVersionService.EXPECT().CreateAppVersion(gomock.Any(), tt.request, true, tt.dryRun).
-					Return(nil).Times(1)
+					Return(nil, nil).Times(1)
 			}
 
 			cmd := &createAppVersionCommand{
@@ -217,9 +217,9 @@ func TestCreateAppVersionCommand_FlagsSuite(t *testing.T) {
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			if !tt.expectsError {
 				mockVersionService.EXPECT().CreateAppVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, req *model.CreateAppVersionRequest, _ bool, _ bool) error {
+					DoAndReturn(func(_ interface{}, req *model.CreateAppVersionRequest, _ bool, _ bool) (*model.AppVersionResponse, error) {
 						actualPayload = req
-						return nil
+						return nil, nil
 					}).Times(1)
 			}
 
@@ -593,6 +593,38 @@ func TestCreateAppVersionCommand_SpecFileSuite(t *testing.T) {
 			expectsError:  true,
 			errorContains: "invalid character",
 		},
+		{
+			name:     "minimal YAML spec file",
+			specPath: "./testfiles/minimal-spec.yaml",
+			args:     []string{"app-min", "0.1.0"},
+			expectsPayload: &model.CreateAppVersionRequest{
+				ApplicationKey: "app-min",
+				Version:        "0.1.0",
+				Draft:          false,
+				Sources: &model.CreateVersionSources{
+					Packages: []model.CreateVersionPackage{{
+						Type:       "npm",
+						Name:       "pkg-min",
+						Version:    "0.1.0",
+						Repository: "repo-min",
+					}},
+				},
+			},
+		},
+		{
+			name:          "invalid YAML spec file",
+			specPath:      "./testfiles/invalid-spec.yaml",
+			args:          []string{"app-invalid", "0.1.0"},
+			expectsError:  true,
+			errorContains: "invalid spec file './testfiles/invalid-spec.yaml': line 3: mapping values are not allowed in this context",
+		},
+		{
+			name:          "empty YAML spec file",
+			specPath:      "./testfiles/empty-spec.yaml",
+			args:          []string{"app-empty", "0.0.1"},
+			expectsError:  true,
+			errorContains: "Spec file is empty",
+		},
 		{
 			name:     "unknown fields in spec file",
 			specPath: "./testfiles/unknown-fields-spec.json",
@@ -828,10 +860,10 @@ func TestCreateAppVersionCommand_SpecFileSuite(t *testing.T) {
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			if !tt.expectsError {
 				mockVersionService.EXPECT().CreateAppVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, req *model.CreateAppVersionRequest, sync, dryRun bool) error {
+					DoAndReturn(func(_ interface{}, req *model.CreateAppVersionRequest, sync, dryRun bool) (*model.AppVersionResponse, error) {
 						actualPayload = req
 						capturedSync = sync
-						return nil
+						return nil, nil
 					}).Times(1)
 			}
 
@@ -897,9 +929,9 @@ func TestCreateAppVersionCommand_SyncFlagSuite(t *testing.T) {
 			var capturedSync bool
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			mockVersionService.EXPECT().CreateAppVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-				DoAndReturn(func(_ interface{}, req *model.CreateAppVersionRequest, sync, dryRun bool) error {
+				DoAndReturn(func(_ interface{}, req *model.CreateAppVersionRequest, sync, dryRun bool) (*model.AppVersionResponse, error) {
 					capturedSync = sync
-					return nil
+					return nil, nil
 				}).Times(1)
 
 			cmd := &createAppVersionCommand{
@@ -5,28 +5,34 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type deleteAppVersionCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	version        string
 }
 
 func (dv *deleteAppVersionCommand) Run() error {
-	ctx, err := service.NewContext(*dv.serverDetails)
+	ctx, err := service.NewContext(*dv.serverDetails, dv.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return dv.versionService.DeleteAppVersion(ctx, dv.applicationKey, dv.version)
+	if err = dv.versionService.DeleteAppVersion(ctx, dv.applicationKey, dv.version); err != nil {
+		return err
+	}
+	log.Info("Application version deleted successfully.")
+	return nil
 }
 
 func (dv *deleteAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -50,8 +56,14 @@ func (dv *deleteAppVersionCommand) prepareAndRunCommand(ctx *components.Context)
 	if err != nil {
 		return err
 	}
+	var release func()
+	dv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 
-	return commonCLiCommands.Exec(dv)
+	return utils.ExecCommand(ctx, dv)
 }
 
 func GetDeleteAppVersionCommand(appContext app.Context) components.Command {
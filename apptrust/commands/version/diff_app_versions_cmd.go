@@ -0,0 +1,168 @@
+package version
+
+import (
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+// exitCodeDifferencesFound is returned when the compared versions differ, so that scripts can tell differences
+// apart from failures. It doesn't collide with the exit codes of jfrog-cli-core (0-3) and of API errors (10-16).
+var exitCodeDifferencesFound = coreutils.ExitCode{Code: 20}
+
+type diffAppVersionsCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
+	base           model.VersionRef
+	target         model.VersionRef
+	format         string
+}
+
+// versionDiffRow is a single row of the version diff table.
+type versionDiffRow struct {
+	Change string `col-name:"Change"`
+	Kind   string `col-name:"Type"`
+	Name   string `col-name:"Name"`
+	Base   string `col-name:"Base"`
+	Target string `col-name:"Target"`
+}
+
+func (dv *diffAppVersionsCommand) Run() error {
+	ctx, err := service.NewContext(*dv.serverDetails, dv.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	diff, err := dv.versionService.DiffAppVersions(ctx, dv.base, dv.target)
+	if err != nil {
+		return err
+	}
+
+	if err = output.PrintWithTable(dv.format, diff, toVersionDiffRows(diff), "No differences were found"); err != nil {
+		return err
+	}
+
+	if diff.HasDifferences() {
+		return coreutils.CliError{ExitCode: exitCodeDifferencesFound}
+	}
+	return nil
+}
+
+func (dv *diffAppVersionsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return dv.serverDetails, nil
+}
+
+func (dv *diffAppVersionsCommand) CommandName() string {
+	return commands.VersionDiff
+}
+
+func (dv *diffAppVersionsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 3 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	dv.base = model.VersionRef{ApplicationKey: ctx.Arguments[0], Version: ctx.Arguments[1]}
+	dv.target = model.VersionRef{ApplicationKey: ctx.Arguments[0], Version: ctx.Arguments[2]}
+	if targetApplication := ctx.GetStringFlagValue(commands.TargetApplicationFlag); targetApplication != "" {
+		dv.target.ApplicationKey = targetApplication
+	}
+
+	dv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(dv.format); err != nil {
+		return err
+	}
+
+	var err error
+	dv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	dv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(dv)
+}
+
+func toVersionDiffRows(diff *model.VersionDiff) []versionDiffRow {
+	rows := make([]versionDiffRow, 0, len(diff.Packages)+len(diff.Artifacts))
+	for _, packageDiff := range diff.Packages {
+		rows = append(rows, versionDiffRow{
+			Change: packageDiff.Change,
+			Kind:   packageDiff.PackageType,
+			Name:   packageDiff.Name,
+			Base:   formatDiffValue(packageDiff.BaseVersion, packageDiff.BaseSHA256),
+			Target: formatDiffValue(packageDiff.TargetVersion, packageDiff.TargetSHA256),
+		})
+	}
+	for _, artifactDiff := range diff.Artifacts {
+		rows = append(rows, versionDiffRow{
+			Change: artifactDiff.Change,
+			Kind:   "artifact",
+			Name:   artifactDiff.Path,
+			Base:   formatDiffValue("", artifactDiff.BaseSHA256),
+			Target: formatDiffValue("", artifactDiff.TargetSHA256),
+		})
+	}
+	return rows
+}
+
+// formatDiffValue renders a version and an abbreviated sha256 as a single table cell.
+func formatDiffValue(version, sha256 string) string {
+	const shortSHA256Length = 12
+	if len(sha256) > shortSHA256Length {
+		sha256 = sha256[:shortSHA256Length]
+	}
+	switch {
+	case version == "":
+		return sha256
+	case sha256 == "":
+		return version
+	default:
+		return version + " (" + sha256 + ")"
+	}
+}
+
+func GetDiffAppVersionsCommand(appContext app.Context) components.Command {
+	cmd := &diffAppVersionsCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionDiff,
+		Description: "Compare the packages and artifacts of two application versions. Exits with code 0 when the versions are identical, and with code 20 when differences were found.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vdf"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version-a",
+				Description: "The base version to compare from.",
+				Optional:    false,
+			},
+			{
+				Name:        "version-b",
+				Description: "The target version to compare to.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionDiff),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,105 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffAppVersionsCommand_Run(t *testing.T) {
+	base := model.VersionRef{ApplicationKey: "app-a", Version: "1.0.0"}
+	target := model.VersionRef{ApplicationKey: "app-b", Version: "2.0.0"}
+
+	tests := []struct {
+		name             string
+		format           string
+		diff             *model.VersionDiff
+		expectedExitCode *coreutils.ExitCode
+	}{
+		{
+			name:   "identical versions",
+			format: output.FormatTable,
+			diff:   &model.VersionDiff{Base: base, Target: target, Packages: []model.PackageDiff{}, Artifacts: []model.ArtifactDiff{}},
+		},
+		{
+			name:   "differences in table format",
+			format: output.FormatTable,
+			diff: &model.VersionDiff{Base: base, Target: target,
+				Packages: []model.PackageDiff{{Change: model.DiffChangeChanged, PackageType: "npm", Name: "lib", BaseVersion: "1.0.0", TargetVersion: "1.1.0"}}},
+			expectedExitCode: &exitCodeDifferencesFound,
+		},
+		{
+			name:   "differences in json format",
+			format: output.FormatJson,
+			diff: &model.VersionDiff{Base: base, Target: target,
+				Artifacts: []model.ArtifactDiff{{Change: model.DiffChangeAdded, Path: "generic/file.bin", TargetSHA256: "abc"}}},
+			expectedExitCode: &exitCodeDifferencesFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().DiffAppVersions(gomock.Any(), base, target).Return(tt.diff, nil).Times(1)
+
+			cmd := &diffAppVersionsCommand{
+				versionService: mockVersionService,
+				serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+				base:           base,
+				target:         target,
+				format:         tt.format,
+			}
+
+			err := cmd.Run()
+			if tt.expectedExitCode == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var cliError coreutils.CliError
+			assert.ErrorAs(t, err, &cliError)
+			assert.Equal(t, *tt.expectedExitCode, cliError.ExitCode)
+		})
+	}
+}
+
+func TestDiffAppVersionsCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().DiffAppVersions(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("service error occurred")).Times(1)
+
+	cmd := &diffAppVersionsCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "service error occurred")
+}
+
+func TestToVersionDiffRows(t *testing.T) {
+	rows := toVersionDiffRows(&model.VersionDiff{
+		Packages: []model.PackageDiff{
+			{Change: model.DiffChangeChanged, PackageType: "npm", Name: "lib", BaseVersion: "1.0.0", TargetVersion: "1.0.0", BaseSHA256: "0123456789abcdef", TargetSHA256: "fedcba9876543210"},
+		},
+		Artifacts: []model.ArtifactDiff{
+			{Change: model.DiffChangeRemoved, Path: "generic/file.bin", BaseSHA256: "abc"},
+		},
+	})
+	assert.Equal(t, []versionDiffRow{
+		{Change: model.DiffChangeChanged, Kind: "npm", Name: "lib", Base: "1.0.0 (0123456789ab)", Target: "1.0.0 (fedcba987654)"},
+		{Change: model.DiffChangeRemoved, Kind: "artifact", Name: "generic/file.bin", Base: "abc"},
+	}, rows)
+}
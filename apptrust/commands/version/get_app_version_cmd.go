@@ -0,0 +1,100 @@
+package version
+
+import (
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type getAppVersionCommand struct {
+	versionService   versions.VersionService
+	serverDetails    *coreConfig.ServerDetails
+	clientOptions    []apphttp.ClientOption
+	applicationKey   string
+	version          string
+	includeArtifacts bool
+	format           string
+}
+
+func (gv *getAppVersionCommand) Run() error {
+	ctx, err := service.NewContext(*gv.serverDetails, gv.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	versionContent, err := gv.versionService.GetAppVersion(ctx, gv.applicationKey, gv.version, gv.includeArtifacts)
+	if err != nil {
+		return err
+	}
+	return output.Print(gv.format, versionContent)
+}
+
+func (gv *getAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return gv.serverDetails, nil
+}
+
+func (gv *getAppVersionCommand) CommandName() string {
+	return commands.VersionGet
+}
+
+func (gv *getAppVersionCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	gv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(gv.format); err != nil {
+		return err
+	}
+
+	gv.applicationKey = ctx.Arguments[0]
+	gv.version = ctx.Arguments[1]
+	gv.includeArtifacts = ctx.GetBoolTFlagValue(commands.ContentFlag)
+
+	var err error
+	gv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	gv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(gv)
+}
+
+func GetGetAppVersionCommand(appContext app.Context) components.Command {
+	cmd := &getAppVersionCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionGet,
+		Description: "Get the details and resolved content of an application version.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vg"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to get.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionGet),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
@@ -0,0 +1,64 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAppVersionCommand_Run(t *testing.T) {
+	tests := []struct {
+		name             string
+		includeArtifacts bool
+	}{
+		{name: "with artifacts", includeArtifacts: true},
+		{name: "without artifacts", includeArtifacts: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVersionService := mockversions.NewMockVersionService(ctrl)
+			mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0", tt.includeArtifacts).
+				Return(&model.VersionContentResponse{ApplicationKey: "app-key", Version: "1.0.0"}, nil).Times(1)
+
+			cmd := &getAppVersionCommand{
+				versionService:   mockVersionService,
+				serverDetails:    &config.ServerDetails{Url: "https://example.com"},
+				applicationKey:   "app-key",
+				version:          "1.0.0",
+				includeArtifacts: tt.includeArtifacts,
+			}
+
+			err := cmd.Run()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetAppVersionCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().GetAppVersion(gomock.Any(), "app-key", "1.0.0", true).
+		Return(nil, errors.New("failed to get app version. Status code: 404")).Times(1)
+
+	cmd := &getAppVersionCommand{
+		versionService:   mockVersionService,
+		serverDetails:    &config.ServerDetails{Url: "https://example.com"},
+		applicationKey:   "app-key",
+		version:          "1.0.0",
+		includeArtifacts: true,
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "failed to get app version. Status code: 404")
+}
@@ -0,0 +1,139 @@
+package version
+
+import (
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type listAppVersionsCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
+	applicationKey string
+	request        *model.ListAppVersionsRequest
+	format         string
+}
+
+func (lv *listAppVersionsCommand) Run() error {
+	ctx, err := service.NewContext(*lv.serverDetails, lv.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	return output.PrintItems(lv.format, lv.versionService.ListAppVersions(ctx, lv.applicationKey, lv.request))
+}
+
+func (lv *listAppVersionsCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return lv.serverDetails, nil
+}
+
+func (lv *listAppVersionsCommand) CommandName() string {
+	return commands.VersionList
+}
+
+func (lv *listAppVersionsCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	lv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(lv.format); err != nil {
+		return err
+	}
+
+	lv.applicationKey = ctx.Arguments[0]
+
+	var err error
+	lv.request, err = lv.buildRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	lv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	lv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(lv)
+}
+
+func (lv *listAppVersionsCommand) buildRequest(ctx *components.Context) (*model.ListAppVersionsRequest, error) {
+	request := &model.ListAppVersionsRequest{
+		Stage: ctx.GetStringFlagValue(commands.StageVarsFlag),
+		Tag:   ctx.GetStringFlagValue(commands.TagFlag),
+	}
+
+	if ctx.IsFlagSet(commands.DraftFlag) {
+		draft := ctx.GetBoolFlagValue(commands.DraftFlag)
+		request.Draft = &draft
+	}
+
+	var err error
+	request.ReleaseStatus, err = utils.ValidateEnumFlag(commands.ReleaseStatusFlag, ctx.GetStringFlagValue(commands.ReleaseStatusFlag), "", model.ReleaseStatusValues)
+	if err != nil {
+		return nil, err
+	}
+
+	request.CreatedAfter, err = utils.ParseTimeFlag(commands.CreatedAfterFlag, ctx.GetStringFlagValue(commands.CreatedAfterFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	request.CreatedBefore, err = utils.ParseTimeFlag(commands.CreatedBeforeFlag, ctx.GetStringFlagValue(commands.CreatedBeforeFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	request.SortBy, err = utils.ValidateEnumFlag(commands.SortByFlag, ctx.GetStringFlagValue(commands.SortByFlag), "", model.VersionSortByValues)
+	if err != nil {
+		return nil, err
+	}
+
+	request.SortOrder, err = utils.ValidateEnumFlag(commands.SortOrderFlag, ctx.GetStringFlagValue(commands.SortOrderFlag), model.SortOrderDesc, model.SortOrderValues)
+	if err != nil {
+		return nil, err
+	}
+
+	request.MaxItems, err = utils.ParseLimitFlag(commands.LimitFlag, ctx.GetStringFlagValue(commands.LimitFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func GetListAppVersionsCommand(appContext app.Context) components.Command {
+	cmd := &listAppVersionsCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionList,
+		Description: "List the versions of an application.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vl"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionList),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
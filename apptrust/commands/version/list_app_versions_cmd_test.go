@@ -0,0 +1,152 @@
+package version
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAppVersionsCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+	request := &model.ListAppVersionsRequest{SortBy: model.VersionSortByCreated, SortOrder: model.SortOrderDesc}
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", request).
+		Return(apphttp.Sequence([]model.AppVersion{{Version: "1.0.0"}}, nil)).Times(1)
+
+	cmd := &listAppVersionsCommand{
+		versionService: mockVersionService,
+		serverDetails:  serverDetails,
+		applicationKey: "app-key",
+		request:        request,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestListAppVersionsCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serverDetails := &config.ServerDetails{Url: "https://example.com"}
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().ListAppVersions(gomock.Any(), "app-key", gomock.Any()).
+		Return(apphttp.Sequence[model.AppVersion](nil, errors.New("list error"))).Times(1)
+
+	cmd := &listAppVersionsCommand{
+		versionService: mockVersionService,
+		serverDetails:  serverDetails,
+		applicationKey: "app-key",
+		request:        &model.ListAppVersionsRequest{},
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "list error")
+}
+
+func TestListAppVersionsCommand_BuildRequest(t *testing.T) {
+	draft := false
+
+	tests := []struct {
+		name            string
+		ctxSetup        func(*components.Context)
+		expectsError    bool
+		errorContains   string
+		expectedRequest *model.ListAppVersionsRequest
+	}{
+		{
+			name:     "defaults",
+			ctxSetup: func(ctx *components.Context) {},
+			expectedRequest: &model.ListAppVersionsRequest{
+				SortOrder: model.SortOrderDesc,
+			},
+		},
+		{
+			name: "all filters",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.StageVarsFlag, "QA")
+				ctx.AddStringFlag(commands.TagFlag, "rc")
+				ctx.AddStringFlag(commands.ReleaseStatusFlag, model.ReleaseStatusReleased)
+				ctx.AddBoolFlag(commands.DraftFlag, false)
+				ctx.AddStringFlag(commands.CreatedAfterFlag, "2025-01-01")
+				ctx.AddStringFlag(commands.CreatedBeforeFlag, "2025-02-01T12:00:00Z")
+				ctx.AddStringFlag(commands.SortByFlag, model.VersionSortBySemver)
+				ctx.AddStringFlag(commands.SortOrderFlag, model.SortOrderAsc)
+				ctx.AddStringFlag(commands.LimitFlag, "10")
+			},
+			expectedRequest: &model.ListAppVersionsRequest{
+				Stage:         "QA",
+				Tag:           "rc",
+				ReleaseStatus: model.ReleaseStatusReleased,
+				Draft:         &draft,
+				CreatedAfter:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				CreatedBefore: time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC),
+				SortBy:        model.VersionSortBySemver,
+				SortOrder:     model.SortOrderAsc,
+				MaxItems:      10,
+			},
+		},
+		{
+			name: "invalid release status",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.ReleaseStatusFlag, "unknown")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --release-status",
+		},
+		{
+			name: "invalid creation time",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.CreatedAfterFlag, "last week")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --created-after",
+		},
+		{
+			name: "invalid sort field",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.SortByFlag, "name")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --sort-by",
+		},
+		{
+			name: "invalid limit",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.LimitFlag, "0")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{}
+			tt.ctxSetup(ctx)
+
+			cmd := &listAppVersionsCommand{}
+			request, err := cmd.buildRequest(ctx)
+			if tt.expectsError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRequest, request)
+		})
+	}
+}
@@ -7,10 +7,11 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -20,19 +21,25 @@ import (
 type promoteAppVersionCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	version        string
 	requestPayload *model.PromoteAppVersionRequest
 	sync           bool
+	format         string
 }
 
 func (pv *promoteAppVersionCommand) Run() error {
-	ctx, err := service.NewContext(*pv.serverDetails)
+	ctx, err := service.NewContext(*pv.serverDetails, pv.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return pv.versionService.PromoteAppVersion(ctx, pv.applicationKey, pv.version, pv.requestPayload, pv.sync)
+	promotion, err := pv.versionService.PromoteAppVersion(ctx, pv.applicationKey, pv.version, pv.requestPayload, pv.sync)
+	if err != nil {
+		return withVersionGetHint(err)
+	}
+	return output.Print(pv.format, promotion)
 }
 
 func (pv *promoteAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -48,6 +55,11 @@ func (pv *promoteAppVersionCommand) prepareAndRunCommand(ctx *components.Context
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	pv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(pv.format); err != nil {
+		return err
+	}
+
 	// Extract from arguments
 	pv.applicationKey = ctx.Arguments[0]
 	pv.version = ctx.Arguments[1]
@@ -60,11 +72,17 @@ func (pv *promoteAppVersionCommand) prepareAndRunCommand(ctx *components.Context
 		return err
 	}
 	pv.serverDetails = serverDetails
+	var release func()
+	pv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 	pv.requestPayload, err = pv.buildRequestPayload(ctx)
 	if errorutils.CheckError(err) != nil {
 		return err
 	}
-	return commonCLiCommands.Exec(pv)
+	return utils.ExecCommand(ctx, pv)
 }
 
 func (pv *promoteAppVersionCommand) buildRequestPayload(ctx *components.Context) (*model.PromoteAppVersionRequest, error) {
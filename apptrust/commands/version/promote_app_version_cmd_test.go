@@ -60,7 +60,7 @@ func TestPromoteAppVersionCommand_Run(t *testing.T) {
 
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), applicationKey, version, requestPayload, tt.sync).
-				Return(nil).Times(1)
+				Return(nil, nil).Times(1)
 
 			cmd := &promoteAppVersionCommand{
 				versionService: mockVersionService,
@@ -92,7 +92,7 @@ func TestPromoteAppVersionCommand_Run_Error(t *testing.T) {
 
 	mockVersionService := mockversions.NewMockVersionService(ctrl)
 	mockVersionService.EXPECT().PromoteAppVersion(gomock.Any(), applicationKey, version, requestPayload, sync).
-		Return(expectedError).Times(1)
+		Return(nil, expectedError).Times(1)
 
 	cmd := &promoteAppVersionCommand{
 		versionService: mockVersionService,
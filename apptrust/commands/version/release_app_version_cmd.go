@@ -7,10 +7,11 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -20,19 +21,25 @@ import (
 type releaseAppVersionCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	version        string
 	requestPayload *model.ReleaseAppVersionRequest
 	sync           bool
+	format         string
 }
 
 func (rv *releaseAppVersionCommand) Run() error {
-	ctx, err := service.NewContext(*rv.serverDetails)
+	ctx, err := service.NewContext(*rv.serverDetails, rv.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return rv.versionService.ReleaseAppVersion(ctx, rv.applicationKey, rv.version, rv.requestPayload, rv.sync)
+	release, err := rv.versionService.ReleaseAppVersion(ctx, rv.applicationKey, rv.version, rv.requestPayload, rv.sync)
+	if err != nil {
+		return withVersionGetHint(err)
+	}
+	return output.Print(rv.format, release)
 }
 
 func (rv *releaseAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -48,6 +55,11 @@ func (rv *releaseAppVersionCommand) prepareAndRunCommand(ctx *components.Context
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	rv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(rv.format); err != nil {
+		return err
+	}
+
 	// Extract from arguments
 	rv.applicationKey = ctx.Arguments[0]
 	rv.version = ctx.Arguments[1]
@@ -60,11 +72,17 @@ func (rv *releaseAppVersionCommand) prepareAndRunCommand(ctx *components.Context
 		return err
 	}
 	rv.serverDetails = serverDetails
+	var release func()
+	rv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 	rv.requestPayload, err = rv.buildRequestPayload(ctx)
 	if errorutils.CheckError(err) != nil {
 		return err
 	}
-	return commonCLiCommands.Exec(rv)
+	return utils.ExecCommand(ctx, rv)
 }
 
 func (rv *releaseAppVersionCommand) buildRequestPayload(ctx *components.Context) (*model.ReleaseAppVersionRequest, error) {
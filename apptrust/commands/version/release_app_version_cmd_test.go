@@ -61,7 +61,7 @@ func TestReleaseAppVersionCommand_Run(t *testing.T) {
 
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			mockVersionService.EXPECT().ReleaseAppVersion(gomock.Any(), applicationKey, version, requestPayload, tt.sync).
-				Return(nil).Times(1)
+				Return(nil, nil).Times(1)
 
 			cmd := &releaseAppVersionCommand{
 				versionService: mockVersionService,
@@ -96,7 +96,7 @@ func TestReleaseAppVersionCommand_Run_Error(t *testing.T) {
 
 	mockVersionService := mockversions.NewMockVersionService(ctrl)
 	mockVersionService.EXPECT().ReleaseAppVersion(gomock.Any(), applicationKey, version, requestPayload, false).
-		Return(expectedError).Times(1)
+		Return(nil, expectedError).Times(1)
 
 	cmd := &releaseAppVersionCommand{
 		versionService: mockVersionService,
@@ -7,10 +7,11 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -19,20 +20,26 @@ import (
 type rollbackAppVersionCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	version        string
 	requestPayload *model.RollbackAppVersionRequest
 	fromStage      string
 	sync           bool
+	format         string
 }
 
 func (rv *rollbackAppVersionCommand) Run() error {
-	ctx, err := service.NewContext(*rv.serverDetails)
+	ctx, err := service.NewContext(*rv.serverDetails, rv.clientOptions...)
 	if err != nil {
 		return err
 	}
 
-	return rv.versionService.RollbackAppVersion(ctx, rv.applicationKey, rv.version, rv.requestPayload, rv.sync)
+	rollback, err := rv.versionService.RollbackAppVersion(ctx, rv.applicationKey, rv.version, rv.requestPayload, rv.sync)
+	if err != nil {
+		return withVersionGetHint(err)
+	}
+	return output.Print(rv.format, rollback)
 }
 
 func (rv *rollbackAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -48,6 +55,11 @@ func (rv *rollbackAppVersionCommand) prepareAndRunCommand(ctx *components.Contex
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	rv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(rv.format); err != nil {
+		return err
+	}
+
 	rv.applicationKey = ctx.Arguments[0]
 	rv.version = ctx.Arguments[1]
 	rv.fromStage = ctx.Arguments[2]
@@ -59,9 +71,15 @@ func (rv *rollbackAppVersionCommand) prepareAndRunCommand(ctx *components.Contex
 		return err
 	}
 	rv.serverDetails = serverDetails
+	var release func()
+	rv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 	rv.requestPayload = model.NewRollbackAppVersionRequest(rv.fromStage)
 
-	return commonCLiCommands.Exec(rv)
+	return utils.ExecCommand(ctx, rv)
 }
 
 func GetRollbackAppVersionCommand(appContext app.Context) components.Command {
@@ -63,7 +63,7 @@ func TestRollbackAppVersionCommand_Run(t *testing.T) {
 
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			mockVersionService.EXPECT().RollbackAppVersion(gomock.Any(), tt.applicationKey, tt.version, requestPayload, tt.sync).
-				Return(tt.mockError).Times(1)
+				Return(nil, tt.mockError).Times(1)
 
 			cmd := &rollbackAppVersionCommand{
 				versionService: mockVersionService,
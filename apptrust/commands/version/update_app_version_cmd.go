@@ -3,14 +3,16 @@ package version
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"errors"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -21,13 +23,14 @@ import (
 type updateAppVersionCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	version        string
 	requestPayload *model.UpdateAppVersionRequest
 }
 
 func (uv *updateAppVersionCommand) Run() error {
-	ctx, err := service.NewContext(*uv.serverDetails)
+	ctx, err := service.NewContext(*uv.serverDetails, uv.clientOptions...)
 	if err != nil {
 		log.Error("Failed to create service context:", err)
 		return err
@@ -35,9 +38,13 @@ func (uv *updateAppVersionCommand) Run() error {
 
 	err = uv.versionService.UpdateAppVersion(ctx, uv.applicationKey, uv.version, uv.requestPayload)
 	if err != nil {
-		log.Error("Failed to update application version:", err)
+		// With --plan, the request is printed rather than sent, which isn't a failure.
+		if !errors.Is(err, apphttp.ErrPlanned) {
+			log.Error("Failed to update application version:", err)
+		}
 		return err
 	}
+	log.Info("Application version updated successfully.")
 
 	return nil
 }
@@ -65,7 +72,14 @@ func (uv *updateAppVersionCommand) prepareAndRunCommand(ctx *components.Context)
 		return err
 	}
 
-	return commonCLiCommands.Exec(uv)
+	var release func()
+	uv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return utils.ExecCommand(ctx, uv)
 }
 
 // parseFlagsAndSetFields parses CLI flags and sets struct fields accordingly.
@@ -1,19 +1,90 @@
 package version
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/fakeserver"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/stretchr/testify/assert"
 )
 
+var fakeServerPackage = fakeserver.Package{Type: "npm", Name: "web-ui", Version: "1.0.0", RepositoryKey: "npm-local"}
+
+// startVersionServer starts a test server with the draft version 1.0.0 of the "app" application, and returns
+// the context of a command that targets the server, and a service context to check the version with.
+func startVersionServer(t *testing.T) (*components.Context, service.Context) {
+	t.Setenv(coreutils.CI, "true")
+	server, serverDetails := fakeserver.StartTestServer(t)
+	require.NoError(t, server.Seed(fakeserver.SeedData{
+		Applications: []model.AppDescriptor{{ApplicationKey: "app", ProjectKey: "proj"}},
+		Packages:     []fakeserver.Package{fakeServerPackage},
+	}))
+	serviceCtx, err := service.NewContext(*serverDetails, apphttp.WithRetries(0))
+	require.NoError(t, err)
+	_, err = versions.NewVersionService().CreateAppVersion(serviceCtx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.0", Draft: true}, true, false)
+	require.NoError(t, err)
+
+	ctx := &components.Context{Arguments: []string{"app", "1.0.0"}}
+	ctx.AddStringFlag("url", serverDetails.Url)
+	ctx.AddStringFlag(commands.AccessTokenFlag, serverDetails.AccessToken)
+	ctx.AddStringFlag(commands.TimeoutFlag, "1m")
+	return ctx, serviceCtx
+}
+
+// TestUpdateAppVersionCommand_FakeServer runs the command with the request context of its flags,
+// which must stay open until the request is sent.
+func TestUpdateAppVersionCommand_FakeServer(t *testing.T) {
+	ctx, serviceCtx := startVersionServer(t)
+	ctx.AddStringFlag(commands.TagFlag, "release-candidate")
+
+	cmd := &updateAppVersionCommand{versionService: versions.NewVersionService()}
+	require.NoError(t, cmd.prepareAndRunCommand(ctx))
+
+	content, err := versions.NewVersionService().GetAppVersion(serviceCtx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	assert.Equal(t, "release-candidate", content.Tag)
+}
+
+// captureLog returns the buffer that receives the log messages and the output of the commands until the test ends.
+func captureLog(t *testing.T) *bytes.Buffer {
+	var buffer bytes.Buffer
+	previousLogger := log.GetLogger()
+	log.SetLogger(log.NewLogger(log.INFO, &buffer))
+	t.Cleanup(func() { log.SetLogger(previousLogger) })
+	return &buffer
+}
+
+// TestUpdateAppVersionCommand_Plan checks that the planned request is printed, and isn't reported as a failure.
+func TestUpdateAppVersionCommand_Plan(t *testing.T) {
+	ctx, serviceCtx := startVersionServer(t)
+	ctx.AddStringFlag(commands.TagFlag, "release-candidate")
+	ctx.AddBoolFlag(commands.PlanFlag, true)
+	logOutput := captureLog(t)
+
+	cmd := &updateAppVersionCommand{versionService: versions.NewVersionService()}
+	require.NoError(t, cmd.prepareAndRunCommand(ctx))
+	assert.Contains(t, logOutput.String(), `"method": "PATCH"`)
+	assert.NotContains(t, logOutput.String(), "[Error]")
+
+	content, err := versions.NewVersionService().GetAppVersion(serviceCtx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	assert.Empty(t, content.Tag)
+}
+
 func TestUpdateAppVersionCommand_Run(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1,14 +1,17 @@
 package version
 
 import (
+	"errors"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
-	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -19,28 +22,34 @@ import (
 type updateAppVersionSourcesCommand struct {
 	versionService versions.VersionService
 	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
 	applicationKey string
 	version        string
 	requestPayload *model.UpdateVersionSourcesRequest
 	sync           bool
 	dryRun         bool
 	failFast       bool
+	format         string
 }
 
 func (cmd *updateAppVersionSourcesCommand) Run() error {
-	ctx, err := service.NewContext(*cmd.serverDetails)
+	ctx, err := service.NewContext(*cmd.serverDetails, cmd.clientOptions...)
 	if err != nil {
 		log.Error("Failed to create service context:", err)
 		return err
 	}
 
-	err = cmd.versionService.UpdateAppVersionSources(ctx, cmd.applicationKey, cmd.version, cmd.requestPayload, cmd.sync, cmd.dryRun, cmd.failFast)
+	appVersion, err := cmd.versionService.UpdateAppVersionSources(ctx, cmd.applicationKey, cmd.version, cmd.requestPayload, cmd.sync, cmd.dryRun, cmd.failFast)
 	if err != nil {
-		log.Error("Failed to update application version sources:", err)
-		return err
+		// With --plan, the request is printed rather than sent, which isn't a failure.
+		if !errors.Is(err, apphttp.ErrPlanned) {
+			log.Error("Failed to update application version sources:", err)
+		}
+		return withVersionGetHint(err)
 	}
+	log.Info("Application version sources updated successfully.")
 
-	return nil
+	return output.Print(cmd.format, appVersion)
 }
 
 func (cmd *updateAppVersionSourcesCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
@@ -56,6 +65,11 @@ func (cmd *updateAppVersionSourcesCommand) prepareAndRunCommand(ctx *components.
 		return err
 	}
 
+	cmd.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(cmd.format); err != nil {
+		return err
+	}
+
 	if err := cmd.parseFlagsAndSetFields(ctx); err != nil {
 		return err
 	}
@@ -66,7 +80,14 @@ func (cmd *updateAppVersionSourcesCommand) prepareAndRunCommand(ctx *components.
 		return err
 	}
 
-	return commonCLiCommands.Exec(cmd)
+	var release func()
+	cmd.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return utils.ExecCommand(ctx, cmd)
 }
 
 func validateUpdateSourcesContext(ctx *components.Context) error {
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
@@ -53,10 +55,10 @@ func TestUpdateAppVersionSourcesCommand_Run(t *testing.T) {
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			if tt.shouldError {
 				mockVersionService.EXPECT().UpdateAppVersionSources(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(errors.New(tt.errorMessage)).Times(1)
+					Return(nil, errors.New(tt.errorMessage)).Times(1)
 			} else {
 				mockVersionService.EXPECT().UpdateAppVersionSources(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil).Times(1)
+					Return(nil, nil).Times(1)
 			}
 
 			cmd := &updateAppVersionSourcesCommand{
@@ -243,12 +245,12 @@ func TestUpdateAppVersionSourcesCommand_SourceFlagsSuite(t *testing.T) {
 			mockVersionService := mockversions.NewMockVersionService(ctrl)
 			if !tt.expectsError {
 				mockVersionService.EXPECT().UpdateAppVersionSources(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ interface{}, _ string, _ string, req *model.UpdateVersionSourcesRequest, sync bool, dryRun bool, failFast bool) error {
+					DoAndReturn(func(_ interface{}, _ string, _ string, req *model.UpdateVersionSourcesRequest, sync bool, dryRun bool, failFast bool) (*model.AppVersionResponse, error) {
 						actualPayload = req
 						capturedSync = sync
 						capturedDryRun = dryRun
 						capturedFailFast = failFast
-						return nil
+						return nil, nil
 					}).Times(1)
 			}
 
@@ -272,3 +274,36 @@ func TestUpdateAppVersionSourcesCommand_SourceFlagsSuite(t *testing.T) {
 		})
 	}
 }
+
+// TestUpdateAppVersionSourcesCommand_FakeServer runs the command with the request context of its flags,
+// which must stay open until the request is sent.
+func TestUpdateAppVersionSourcesCommand_FakeServer(t *testing.T) {
+	ctx, serviceCtx := startVersionServer(t)
+	ctx.AddStringFlag(commands.SourceTypePackagesFlag, "type=npm,name=web-ui,version=1.0.0,repo-key=npm-local")
+	ctx.AddBoolFlag(commands.SyncFlag, true)
+
+	cmd := &updateAppVersionSourcesCommand{versionService: versions.NewVersionService()}
+	require.NoError(t, cmd.prepareAndRunCommand(ctx))
+
+	content, err := versions.NewVersionService().GetAppVersion(serviceCtx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	require.Len(t, content.Releasables, 1)
+	assert.Equal(t, fakeServerPackage.Name, content.Releasables[0].Name)
+}
+
+// TestUpdateAppVersionSourcesCommand_Plan checks that the planned request is printed, and isn't reported as a failure.
+func TestUpdateAppVersionSourcesCommand_Plan(t *testing.T) {
+	ctx, serviceCtx := startVersionServer(t)
+	ctx.AddStringFlag(commands.SourceTypePackagesFlag, "type=npm,name=web-ui,version=1.0.0,repo-key=npm-local")
+	ctx.AddBoolFlag(commands.PlanFlag, true)
+	logOutput := captureLog(t)
+
+	cmd := &updateAppVersionSourcesCommand{versionService: versions.NewVersionService()}
+	require.NoError(t, cmd.prepareAndRunCommand(ctx))
+	assert.Contains(t, logOutput.String(), `"method": "PATCH"`)
+	assert.NotContains(t, logOutput.String(), "[Error]")
+
+	content, err := versions.NewVersionService().GetAppVersion(serviceCtx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	assert.Empty(t, content.Releasables)
+}
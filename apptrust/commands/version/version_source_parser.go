@@ -1,7 +1,6 @@
 package version
 
 import (
-	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -9,20 +8,9 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
-	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
-	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 )
 
-type versionSpec struct {
-	Artifacts      []model.CreateVersionArtifact      `json:"artifacts,omitempty"`
-	Packages       []model.CreateVersionPackage       `json:"packages,omitempty"`
-	Builds         []model.CreateVersionBuild         `json:"builds,omitempty"`
-	ReleaseBundles []model.CreateVersionReleaseBundle `json:"release_bundles,omitempty"`
-	Versions       []model.CreateVersionReference     `json:"versions,omitempty"`
-	Filters        *model.CreateVersionFilters        `json:"filters,omitempty"`
-}
-
 // validateNoSpecAndFlagsTogether returns error if both --spec and any other source flag or filter flag are set.
 func validateNoSpecAndFlagsTogether(ctx *components.Context) error {
 	if ctx.IsFlagSet(commands.SpecFlag) {
@@ -137,20 +125,8 @@ func buildSourcesFromFlags(ctx *components.Context) (*model.CreateVersionSources
 }
 
 func loadSourcesFromSpec(ctx *components.Context) (*model.CreateVersionSources, *model.CreateVersionFilters, error) {
-	specFilePath := ctx.GetStringFlagValue(commands.SpecFlag)
-	spec := new(versionSpec)
-	specVars := coreutils.SpecVarsStringToMap(ctx.GetStringFlagValue(commands.SpecVarsFlag))
-	content, err := fileutils.ReadFile(specFilePath)
-	if errorutils.CheckError(err) != nil {
-		return nil, nil, err
-	}
-
-	if len(specVars) > 0 {
-		content = coreutils.ReplaceVars(content, specVars)
-	}
-
-	err = json.Unmarshal(content, spec)
-	if errorutils.CheckError(err) != nil {
+	spec := new(model.VersionSpec)
+	if err := utils.LoadSpecFile(ctx, spec); err != nil {
 		return nil, nil, err
 	}
 
@@ -370,7 +346,7 @@ func parseFilters(filterStrings []string) ([]*model.CreateVersionSourceFilter, e
 		filter := &model.CreateVersionSourceFilter{}
 
 		switch filterType {
-		case "package":
+		case model.FilterTypePackage:
 			if val, ok := filterMap[packageTypeField]; ok {
 				filter.PackageType = val
 			}
@@ -383,7 +359,7 @@ func parseFilters(filterStrings []string) ([]*model.CreateVersionSourceFilter, e
 			if filter.PackageType == "" && filter.PackageName == "" && filter.PackageVersion == "" {
 				return nil, errorutils.CheckErrorf("invalid package filter at index %d: at least one of 'type', 'name', or 'version' must be specified", i)
 			}
-		case "artifact":
+		case model.FilterTypeArtifact:
 			if val, ok := filterMap[artifactPathField]; ok {
 				filter.Path = val
 			}
@@ -394,7 +370,7 @@ func parseFilters(filterStrings []string) ([]*model.CreateVersionSourceFilter, e
 				return nil, errorutils.CheckErrorf("invalid artifact filter at index %d: at least one of 'path' or 'sha256' must be specified", i)
 			}
 		default:
-			return nil, errorutils.CheckErrorf("invalid filter_type '%s' at index %d: must be 'package' or 'artifact'", filterType, i)
+			return nil, errorutils.CheckErrorf("invalid filter_type '%s' at index %d: must be '%s' or '%s'", filterType, i, model.FilterTypePackage, model.FilterTypeArtifact)
 		}
 
 		filters = append(filters, filter)
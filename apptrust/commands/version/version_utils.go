@@ -1,11 +1,14 @@
 package version
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
@@ -75,3 +78,13 @@ func ParseOverwriteStrategy(ctx *components.Context) (string, error) {
 	// Convert to uppercase for API request
 	return strings.ToUpper(validatedStrategy), nil
 }
+
+// withVersionGetHint adds the command that checks the status of the version to the error of an interrupted operation.
+func withVersionGetHint(err error) error {
+	var interruptedErr *versions.InterruptedError
+	if !errors.As(err, &interruptedErr) {
+		return err
+	}
+	return fmt.Errorf("%w. Run 'jf apptrust %s %s %s' to check the status of the version",
+		err, commands.VersionGet, interruptedErr.ApplicationKey, interruptedErr.Version)
+}
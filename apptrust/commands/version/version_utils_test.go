@@ -1,10 +1,13 @@
 package version
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/stretchr/testify/assert"
 )
@@ -162,3 +165,14 @@ func TestBuildPromotionParams(t *testing.T) {
 		})
 	}
 }
+
+func TestWithVersionGetHint(t *testing.T) {
+	interruptedErr := &versions.InterruptedError{ApplicationKey: "app", Version: "1.0.0", Err: context.Canceled}
+	err := withVersionGetHint(interruptedErr)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.EqualError(t, err, "context canceled\nThe operation may still be running server-side. "+
+		"Run 'jf apptrust version-get app 1.0.0' to check the status of the version")
+
+	otherErr := errors.New("other error")
+	assert.Equal(t, otherErr, withVersionGetHint(otherErr))
+}
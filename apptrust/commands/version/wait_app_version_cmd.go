@@ -0,0 +1,139 @@
+package version
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/app"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/utils"
+	"github.com/jfrog/jfrog-cli-application/apptrust/common"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/output"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	commonCLiCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type waitAppVersionCommand struct {
+	versionService versions.VersionService
+	serverDetails  *coreConfig.ServerDetails
+	clientOptions  []apphttp.ClientOption
+	applicationKey string
+	version        string
+	request        *model.WaitAppVersionRequest
+	format         string
+}
+
+func (wv *waitAppVersionCommand) Run() error {
+	ctx, err := service.NewContext(*wv.serverDetails, wv.clientOptions...)
+	if err != nil {
+		return err
+	}
+
+	versionContent, err := wv.versionService.WaitForAppVersion(ctx, wv.applicationKey, wv.version, wv.request)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Application version %s:%s reached the '%s' state.", wv.applicationKey, wv.version, wv.request.State))
+	return output.Print(wv.format, versionContent)
+}
+
+func (wv *waitAppVersionCommand) ServerDetails() (*coreConfig.ServerDetails, error) {
+	return wv.serverDetails, nil
+}
+
+func (wv *waitAppVersionCommand) CommandName() string {
+	return commands.VersionWait
+}
+
+func (wv *waitAppVersionCommand) prepareAndRunCommand(ctx *components.Context) error {
+	if len(ctx.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+
+	wv.format = ctx.GetStringFlagValue(commands.FormatFlag)
+	if err := output.ValidateFormat(wv.format); err != nil {
+		return err
+	}
+
+	wv.applicationKey = ctx.Arguments[0]
+	wv.version = ctx.Arguments[1]
+
+	var err error
+	wv.request, err = wv.buildRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	wv.serverDetails, err = utils.ServerDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	var release func()
+	wv.clientOptions, release, err = utils.ClientOptionsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return commonCLiCommands.Exec(wv)
+}
+
+func (wv *waitAppVersionCommand) buildRequest(ctx *components.Context) (*model.WaitAppVersionRequest, error) {
+	state, err := utils.ValidateEnumFlag(commands.WaitStateFlag, ctx.GetStringFlagValue(commands.WaitStateFlag), model.WaitStateCreated, model.WaitStateValues)
+	if err != nil {
+		return nil, err
+	}
+
+	stage := ctx.GetStringFlagValue(commands.StageVarsFlag)
+	if state == model.WaitStatePromoted && stage == "" {
+		return nil, errorutils.CheckErrorf("the --%s option is mandatory when --%s is '%s'", commands.StageVarsFlag, commands.WaitStateFlag, model.WaitStatePromoted)
+	}
+
+	timeout, err := utils.ParseDurationFlag(commands.WaitTimeoutFlag, ctx.GetStringFlagValue(commands.WaitTimeoutFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := utils.ParseDurationFlag(commands.IntervalFlag, ctx.GetStringFlagValue(commands.IntervalFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.WaitAppVersionRequest{
+		State:    state,
+		Stage:    stage,
+		Timeout:  timeout,
+		Interval: interval,
+	}, nil
+}
+
+func GetWaitAppVersionCommand(appContext app.Context) components.Command {
+	cmd := &waitAppVersionCommand{versionService: appContext.GetVersionService()}
+	return components.Command{
+		Name:        commands.VersionWait,
+		Description: "Wait for an application version to reach a state, such as after an asynchronous create or promote. Fails if the version fails or the timeout is reached.",
+		Category:    common.CategoryVersion,
+		Aliases:     []string{"vw"},
+		Arguments: []components.Argument{
+			{
+				Name:        "application-key",
+				Description: "The application key.",
+				Optional:    false,
+			},
+			{
+				Name:        "version",
+				Description: "The version to wait for.",
+				Optional:    false,
+			},
+		},
+		Flags:  commands.GetCommandFlags(commands.VersionWait),
+		Action: cmd.prepareAndRunCommand,
+	}
+}
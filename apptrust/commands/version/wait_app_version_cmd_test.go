@@ -0,0 +1,136 @@
+package version
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockversions "github.com/jfrog/jfrog-cli-application/apptrust/service/versions/mocks"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWaitAppVersionCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &model.WaitAppVersionRequest{State: model.WaitStateCreated, Timeout: time.Minute, Interval: time.Second}
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().WaitForAppVersion(gomock.Any(), "app-key", "1.0.0", request).
+		Return(&model.VersionContentResponse{ApplicationKey: "app-key", Version: "1.0.0", Status: "COMPLETED"}, nil).Times(1)
+
+	cmd := &waitAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		request:        request,
+	}
+
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
+func TestWaitAppVersionCommand_Run_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionService := mockversions.NewMockVersionService(ctrl)
+	mockVersionService.EXPECT().WaitForAppVersion(gomock.Any(), "app-key", "1.0.0", gomock.Any()).
+		Return(nil, errors.New("timed out")).Times(1)
+
+	cmd := &waitAppVersionCommand{
+		versionService: mockVersionService,
+		serverDetails:  &config.ServerDetails{Url: "https://example.com"},
+		applicationKey: "app-key",
+		version:        "1.0.0",
+		request:        &model.WaitAppVersionRequest{},
+	}
+
+	err := cmd.Run()
+	assert.EqualError(t, err, "timed out")
+}
+
+func TestWaitAppVersionCommand_BuildRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctxSetup        func(*components.Context)
+		expectsError    bool
+		errorContains   string
+		expectedRequest *model.WaitAppVersionRequest
+	}{
+		{
+			name: "created",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.WaitTimeoutFlag, "2m")
+				ctx.AddStringFlag(commands.IntervalFlag, "1s")
+			},
+			expectedRequest: &model.WaitAppVersionRequest{
+				State:    model.WaitStateCreated,
+				Timeout:  2 * time.Minute,
+				Interval: time.Second,
+			},
+		},
+		{
+			name: "promoted",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.WaitStateFlag, model.WaitStatePromoted)
+				ctx.AddStringFlag(commands.StageVarsFlag, "QA")
+				ctx.AddStringFlag(commands.WaitTimeoutFlag, "10m")
+				ctx.AddStringFlag(commands.IntervalFlag, "5s")
+			},
+			expectedRequest: &model.WaitAppVersionRequest{
+				State:    model.WaitStatePromoted,
+				Stage:    "QA",
+				Timeout:  10 * time.Minute,
+				Interval: 5 * time.Second,
+			},
+		},
+		{
+			name: "promoted without stage",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.WaitStateFlag, model.WaitStatePromoted)
+			},
+			expectsError:  true,
+			errorContains: "the --stage option is mandatory when --state is 'promoted'",
+		},
+		{
+			name: "invalid state",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.WaitStateFlag, "released")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --state",
+		},
+		{
+			name: "invalid timeout",
+			ctxSetup: func(ctx *components.Context) {
+				ctx.AddStringFlag(commands.WaitTimeoutFlag, "forever")
+				ctx.AddStringFlag(commands.IntervalFlag, "5s")
+			},
+			expectsError:  true,
+			errorContains: "invalid value for --wait-timeout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &components.Context{}
+			tt.ctxSetup(ctx)
+
+			cmd := &waitAppVersionCommand{}
+			request, err := cmd.buildRequest(ctx)
+			if tt.expectsError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRequest, request)
+		})
+	}
+}
@@ -5,4 +5,5 @@ const (
 	CategoryApplication = "application"
 	CategoryVersion     = "version"
 	CategoryPackage     = "package"
+	CategorySpec        = "spec"
 )
@@ -0,0 +1,221 @@
+package fakeserver
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+// applicationKeyPattern matches the application keys that AppTrust accepts.
+var applicationKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{1,63}$`)
+
+type application struct {
+	descriptor model.AppDescriptor
+	versions   map[string]*appVersion
+	// packages maps "type/name" to the bound versions of the package.
+	packages map[string][]string
+}
+
+// lookupApplication returns the application named in the request path, and writes a 404 error if it does not exist.
+// The caller must hold the mutex.
+func (s *Server) lookupApplication(w http.ResponseWriter, r *http.Request) (*application, bool) {
+	applicationKey := r.PathValue("app")
+	app, ok := s.applications[applicationKey]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("application '%s' was not found", applicationKey))
+	}
+	return app, ok
+}
+
+func (s *Server) createApplication(w http.ResponseWriter, r *http.Request) {
+	var descriptor model.AppDescriptor
+	if !decodeBody(w, r, &descriptor) {
+		return
+	}
+	if message := validateApplication(&descriptor); message != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", message)
+		return
+	}
+	// Seeded applications may have no name, but the API requires one.
+	if descriptor.ApplicationName == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "application_name is required")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, exists := s.applications[descriptor.ApplicationKey]; exists {
+		writeError(w, http.StatusConflict, "ALREADY_EXISTS", fmt.Sprintf("application '%s' already exists", descriptor.ApplicationKey))
+		return
+	}
+	descriptor.LabelUpdates = nil
+	s.applications[descriptor.ApplicationKey] = &application{
+		descriptor: descriptor,
+		versions:   map[string]*appVersion{},
+		packages:   map[string][]string{},
+	}
+	writeJson(w, http.StatusCreated, descriptor)
+}
+
+func validateApplication(descriptor *model.AppDescriptor) string {
+	switch {
+	case !applicationKeyPattern.MatchString(descriptor.ApplicationKey):
+		return fmt.Sprintf("invalid application key '%s': must start with a lowercase letter, and contain only lowercase letters, digits and hyphens", descriptor.ApplicationKey)
+	case descriptor.ProjectKey == "":
+		return "project_key is required"
+	}
+	return validateApplicationFields(descriptor)
+}
+
+func validateApplicationFields(descriptor *model.AppDescriptor) string {
+	if descriptor.MaturityLevel != nil && !slices.Contains(model.MaturityLevelValues, *descriptor.MaturityLevel) {
+		return fmt.Sprintf("invalid maturity_level '%s'", *descriptor.MaturityLevel)
+	}
+	if descriptor.BusinessCriticality != nil && !slices.Contains(model.BusinessCriticalityValues, *descriptor.BusinessCriticality) {
+		return fmt.Sprintf("invalid criticality '%s'", *descriptor.BusinessCriticality)
+	}
+	return ""
+}
+
+func (s *Server) getApplication(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if app, ok := s.lookupApplication(w, r); ok {
+		writeJson(w, http.StatusOK, app.descriptor)
+	}
+}
+
+func (s *Server) updateApplication(w http.ResponseWriter, r *http.Request) {
+	var update model.AppDescriptor
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if message := validateApplicationFields(&update); message != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", message)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return
+	}
+	if update.ProjectKey != "" && update.ProjectKey != app.descriptor.ProjectKey {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "the project of an application can't be changed")
+		return
+	}
+
+	descriptor := &app.descriptor
+	if update.ApplicationName != "" {
+		descriptor.ApplicationName = update.ApplicationName
+	}
+	setIfNotNil(&descriptor.Description, update.Description)
+	setIfNotNil(&descriptor.MaturityLevel, update.MaturityLevel)
+	setIfNotNil(&descriptor.BusinessCriticality, update.BusinessCriticality)
+	setIfNotNil(&descriptor.Labels, update.Labels)
+	setIfNotNil(&descriptor.UserOwners, update.UserOwners)
+	setIfNotNil(&descriptor.GroupOwners, update.GroupOwners)
+	if update.LabelUpdates != nil {
+		labels := map[string]string{}
+		if descriptor.Labels != nil {
+			labels = maps.Clone(*descriptor.Labels)
+		}
+		for _, label := range update.LabelUpdates.Remove {
+			if labels[label.Key] == label.Value {
+				delete(labels, label.Key)
+			}
+		}
+		for _, label := range update.LabelUpdates.Add {
+			labels[label.Key] = label.Value
+		}
+		descriptor.Labels = &labels
+	}
+	writeJson(w, http.StatusOK, app.descriptor)
+}
+
+func setIfNotNil[T any](target **T, value *T) {
+	if value != nil {
+		*target = value
+	}
+}
+
+func (s *Server) deleteApplication(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return
+	}
+	if len(app.versions) > 0 {
+		writeError(w, http.StatusConflict, "CONFLICT",
+			fmt.Sprintf("application '%s' has %d versions. Delete its versions first", app.descriptor.ApplicationKey, len(app.versions)))
+		return
+	}
+	delete(s.applications, app.descriptor.ApplicationKey)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listApplications(w http.ResponseWriter, r *http.Request) {
+	offset, limit, ok := pageBounds(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	labels := map[string]string{}
+	if value := query.Get("label"); value != "" {
+		for _, label := range strings.Split(value, ",") {
+			key, labelValue, _ := strings.Cut(label, ":")
+			labels[key] = labelValue
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	applications := []model.AppDescriptor{}
+	for _, app := range s.applications {
+		if matchesApplicationFilters(app.descriptor, query.Get("project_key"), query.Get("owner"),
+			query.Get("maturity_level"), query.Get("criticality"), labels) {
+			applications = append(applications, app.descriptor)
+		}
+	}
+	sort.Slice(applications, func(i, j int) bool { return applications[i].ApplicationKey < applications[j].ApplicationKey })
+	writeJson(w, http.StatusOK, model.ListApplicationsResponse{
+		Applications: page(applications, offset, limit),
+		Offset:       offset,
+		Limit:        limit,
+		Total:        len(applications),
+	})
+}
+
+func matchesApplicationFilters(descriptor model.AppDescriptor, projectKey, owner, maturityLevel, criticality string, labels map[string]string) bool {
+	if projectKey != "" && descriptor.ProjectKey != projectKey {
+		return false
+	}
+	if owner != "" && !hasOwner(descriptor, owner) {
+		return false
+	}
+	if maturityLevel != "" && (descriptor.MaturityLevel == nil || *descriptor.MaturityLevel != maturityLevel) {
+		return false
+	}
+	if criticality != "" && (descriptor.BusinessCriticality == nil || *descriptor.BusinessCriticality != criticality) {
+		return false
+	}
+	for key, value := range labels {
+		if descriptor.Labels == nil || (*descriptor.Labels)[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func hasOwner(descriptor model.AppDescriptor, owner string) bool {
+	return (descriptor.UserOwners != nil && slices.Contains(*descriptor.UserOwners, owner)) ||
+		(descriptor.GroupOwners != nil && slices.Contains(*descriptor.GroupOwners, owner))
+}
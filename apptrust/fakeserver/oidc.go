@@ -0,0 +1,92 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// OidcTokenPath is the path of the OIDC token exchange API of JFrog Access.
+const OidcTokenPath = "/access/api/v1/oidc/token"
+
+// DefaultOidcTokenLifetime is the lifetime of the access tokens issued by the OIDC token exchange.
+const DefaultOidcTokenLifetime = time.Hour
+
+// WithOidcProvider enables the OIDC token exchange with the integration named provider.
+// Any ID token is accepted, and exchanged for an access token that the server accepts until RevokeOidcTokens is called.
+func WithOidcProvider(provider string) Option {
+	return func(s *Server) {
+		s.oidcProvider = provider
+	}
+}
+
+// WithOidcTokenLifetime sets the lifetime reported for the access tokens issued by the OIDC token exchange.
+func WithOidcTokenLifetime(lifetime time.Duration) Option {
+	return func(s *Server) {
+		s.oidcTokenLifetime = lifetime
+	}
+}
+
+// OidcExchangeCount returns the number of ID tokens exchanged for access tokens.
+func (s *Server) OidcExchangeCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.oidcExchanges
+}
+
+// RevokeOidcTokens makes the server reject the access tokens issued so far, as if they expired.
+func (s *Server) RevokeOidcTokens() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	clear(s.oidcTokens)
+}
+
+func (s *Server) isOidcToken(token string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.oidcTokens[token]
+}
+
+type oidcTokenRequest struct {
+	GrantType        string `json:"grant_type"`
+	SubjectTokenType string `json:"subject_token_type"`
+	SubjectToken     string `json:"subject_token"`
+	ProviderName     string `json:"provider_name"`
+}
+
+type oidcTokenResponse struct {
+	AccessToken     string `json:"access_token"`
+	ExpiresIn       uint   `json:"expires_in"`
+	TokenType       string `json:"token_type"`
+	IssuedTokenType string `json:"issued_token_type"`
+	Username        string `json:"username"`
+}
+
+func (s *Server) exchangeOidcToken(w http.ResponseWriter, r *http.Request) {
+	var request oidcTokenRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	if s.oidcProvider == "" || request.ProviderName != s.oidcProvider {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("OIDC provider '%s' not found", request.ProviderName))
+		return
+	}
+	if request.GrantType != "urn:ietf:params:oauth:grant-type:token-exchange" ||
+		request.SubjectTokenType != "urn:ietf:params:oauth:token-type:id_token" || request.SubjectToken == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "a token exchange request with an ID token is required")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.oidcExchanges++
+	token := fmt.Sprintf("oidc-token-%d", s.oidcExchanges)
+	s.oidcTokens[token] = true
+	writeJson(w, http.StatusOK, oidcTokenResponse{
+		AccessToken:     token,
+		ExpiresIn:       uint(s.oidcTokenLifetime / time.Second),
+		TokenType:       "Bearer",
+		IssuedTokenType: "urn:ietf:params:oauth:token-type:access_token",
+		Username:        s.user,
+	})
+}
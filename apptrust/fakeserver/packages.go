@@ -0,0 +1,111 @@
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/gofrog/version"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+func (s *Server) bindPackage(w http.ResponseWriter, r *http.Request) {
+	var request model.BindPackageRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	if request.Type == "" || request.Name == "" || request.Version == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "package_type, package_name and package_version are required")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return
+	}
+	key := request.Type + "/" + request.Name
+	if slices.Contains(app.packages[key], request.Version) {
+		writeError(w, http.StatusConflict, "ALREADY_EXISTS",
+			fmt.Sprintf("package %s:%s:%s is already bound to application '%s'", request.Type, request.Name, request.Version, app.descriptor.ApplicationKey))
+		return
+	}
+	app.packages[key] = append(app.packages[key], request.Version)
+	writeJson(w, http.StatusCreated, model.BindPackageResponse{
+		ApplicationKey: app.descriptor.ApplicationKey,
+		Type:           request.Type,
+		Name:           request.Name,
+		Version:        request.Version,
+	})
+}
+
+func (s *Server) unbindPackage(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return
+	}
+	packageType, packageVersion := r.PathValue("type"), r.PathValue("version")
+	// The name is path-escaped by the client, since names of scoped packages contain a slash.
+	name, err := url.PathUnescape(r.PathValue("name"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("invalid package name '%s'", r.PathValue("name")))
+		return
+	}
+	key := packageType + "/" + name
+	index := slices.Index(app.packages[key], packageVersion)
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "NOT_FOUND",
+			fmt.Sprintf("package %s:%s:%s is not bound to application '%s'", packageType, name, packageVersion, app.descriptor.ApplicationKey))
+		return
+	}
+	app.packages[key] = slices.Delete(app.packages[key], index, index+1)
+	if len(app.packages[key]) == 0 {
+		delete(app.packages, key)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listBoundPackages(w http.ResponseWriter, r *http.Request) {
+	offset, limit, ok := pageBounds(w, r)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return
+	}
+	bindings := []model.PackageBinding{}
+	for key, versions := range app.packages {
+		packageType, name, _ := strings.Cut(key, "/")
+		latest := versions[0]
+		for _, packageVersion := range versions[1:] {
+			// Compare returns 1 when the given version is greater than the receiver.
+			if version.NewVersion(latest).Compare(packageVersion) > 0 {
+				latest = packageVersion
+			}
+		}
+		bindings = append(bindings, model.PackageBinding{Type: packageType, Name: name, NumVersions: len(versions), LatestVersion: latest})
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		if bindings[i].Type != bindings[j].Type {
+			return bindings[i].Type < bindings[j].Type
+		}
+		return bindings[i].Name < bindings[j].Name
+	})
+	writeJson(w, http.StatusOK, model.PackagesResponse{
+		Packages: page(bindings, offset, limit),
+		Offset:   offset,
+		Limit:    limit,
+		Total:    len(bindings),
+	})
+}
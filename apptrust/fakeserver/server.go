@@ -0,0 +1,253 @@
+// Package fakeserver implements an in-memory AppTrust server for offline testing.
+//
+// The server implements the /apptrust/api/v1 endpoints used by the CLI services: applications, versions,
+// promotion, release and rollback, package bindings, ping and version. State is kept in memory and requests are
+// validated with the status codes that AppTrust returns, e.g., 404 for a missing application or 409 for an
+// existing version.
+// Asynchronous operations stay in progress for a configurable delay before they complete.
+//
+// Version sources are resolved against a catalog of packages, builds and release bundles, which stands in for
+// Artifactory and is populated with Seed. Packages and artifacts that are not in the catalog are accepted as is.
+//
+// The OIDC token exchange of JFrog Access is also served, when enabled with WithOidcProvider.
+package fakeserver
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+const (
+	// ApiPath is the path under which the AppTrust API is served.
+	ApiPath = "/apptrust/api/v1"
+
+	DefaultAsyncDelay = 500 * time.Millisecond
+	DefaultUser       = "admin"
+
+	// Version is the AppTrust version reported by the server.
+	Version = "1.0.0-fake"
+)
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithAccessToken makes the server accept only requests with the given bearer token.
+// By default, any request with an Authorization header is accepted.
+func WithAccessToken(token string) Option {
+	return func(s *Server) {
+		s.accessToken = token
+	}
+}
+
+// WithAsyncDelay sets how long asynchronous operations stay in progress before they complete.
+func WithAsyncDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.asyncDelay = delay
+	}
+}
+
+// WithUser sets the user reported as the creator of versions and events.
+func WithUser(user string) Option {
+	return func(s *Server) {
+		s.user = user
+	}
+}
+
+// Server is an in-memory AppTrust server. It is safe for concurrent use.
+type Server struct {
+	mutex        sync.Mutex
+	mux          *http.ServeMux
+	accessToken  string
+	asyncDelay   time.Duration
+	user         string
+	now          func() time.Time
+	requestCount atomic.Int64
+
+	oidcProvider      string
+	oidcTokenLifetime time.Duration
+	oidcTokens        map[string]bool
+	oidcExchanges     int
+
+	applications map[string]*application
+	catalog      catalog
+	// versionSequence orders the versions by creation.
+	versionSequence int
+}
+
+// New creates a Server with no applications and an empty catalog.
+func New(options ...Option) *Server {
+	s := &Server{
+		asyncDelay:   DefaultAsyncDelay,
+		user:         DefaultUser,
+		now:          time.Now,
+		applications: map[string]*application{},
+		catalog:      newCatalog(),
+
+		oidcTokenLifetime: DefaultOidcTokenLifetime,
+		oidcTokens:        map[string]bool{},
+	}
+	for _, option := range options {
+		option(s)
+	}
+	s.mux = http.NewServeMux()
+	s.registerRoutes()
+	return s
+}
+
+// StartTestServer starts a Server on a local port for the duration of the test,
+// and returns it with server details that point the CLI services to it.
+func StartTestServer(t testing.TB, options ...Option) (*Server, *coreConfig.ServerDetails) {
+	s := New(options...)
+	httpServer := httptest.NewServer(s)
+	t.Cleanup(httpServer.Close)
+	token := s.accessToken
+	if token == "" {
+		token = "fake-token"
+	}
+	return s, &coreConfig.ServerDetails{Url: httpServer.URL + "/", AccessToken: token}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Request-Id", "fake-"+strconv.FormatInt(s.requestCount.Add(1), 10))
+	if r.Method == http.MethodPost && r.URL.Path == OidcTokenPath {
+		// The token exchange is authenticated by the ID token in the request body.
+		s.exchangeOidcToken(w, r)
+		return
+	}
+	if !s.isAuthorized(r) {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing or invalid credentials")
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) isAuthorized(r *http.Request) bool {
+	authorization := r.Header.Get("Authorization")
+	if s.accessToken == "" {
+		return authorization != ""
+	}
+	token, found := strings.CutPrefix(authorization, "Bearer ")
+	if found && s.isOidcToken(token) {
+		return true
+	}
+	return found && subtle.ConstantTimeCompare([]byte(token), []byte(s.accessToken)) == 1
+}
+
+func (s *Server) registerRoutes() {
+	routes := map[string]http.HandlerFunc{
+		"GET /system/ping":    s.ping,
+		"GET /system/version": s.version,
+
+		"POST /applications":                                          s.createApplication,
+		"GET /applications":                                           s.listApplications,
+		"GET /applications/{app}":                                     s.getApplication,
+		"PATCH /applications/{app}":                                   s.updateApplication,
+		"DELETE /applications/{app}":                                  s.deleteApplication,
+		"POST /applications/{app}/versions/{$}":                       s.createVersion,
+		"POST /applications/{app}/versions":                           s.createVersion,
+		"GET /applications/{app}/versions":                            s.listVersions,
+		"GET /applications/{app}/versions/{version}/content":          s.getVersionContent,
+		"PATCH /applications/{app}/versions/{version}":                s.updateVersion,
+		"DELETE /applications/{app}/versions/{version}":               s.deleteVersion,
+		"POST /applications/{app}/versions/{version}/promote":         s.promoteVersion,
+		"POST /applications/{app}/versions/{version}/release":         s.releaseVersion,
+		"POST /applications/{app}/versions/{version}/rollback":        s.rollbackVersion,
+		"GET /applications/{app}/versions/{version}/history":          s.getVersionHistory,
+		"POST /applications/{app}/packages":                           s.bindPackage,
+		"GET /applications/{app}/packages":                            s.listBoundPackages,
+		"DELETE /applications/{app}/packages/{type}/{name}/{version}": s.unbindPackage,
+	}
+	for route, handler := range routes {
+		method, path, _ := strings.Cut(route, " ")
+		s.mux.HandleFunc(method+" "+ApiPath+path, handler)
+	}
+	s.mux.HandleFunc(ApiPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("no endpoint for %s %s", r.Method, r.URL.Path))
+	})
+}
+
+func (s *Server) ping(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte("OK"))
+}
+
+func (s *Server) version(w http.ResponseWriter, _ *http.Request) {
+	writeJson(w, http.StatusOK, model.SystemVersion{Version: Version, Revision: "fake"})
+}
+
+// timestamp returns the current time in the format of the AppTrust API.
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+// errorResponse is the AppTrust error format, parsed by apphttp.NewApptrustError.
+type errorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJson(w, statusCode, errorResponse{Code: code, Message: message})
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decodeBody decodes the JSON request body, and writes a 400 error if it is invalid.
+func decodeBody(w http.ResponseWriter, r *http.Request, target interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// boolParam returns the value of a boolean query parameter, or defaultValue if it is not set or invalid.
+func boolParam(r *http.Request, name string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(r.URL.Query().Get(name))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// pageBounds returns the offset and limit query parameters, and writes a 400 error if they are invalid.
+func pageBounds(w http.ResponseWriter, r *http.Request) (offset, limit int, ok bool) {
+	offset, limit = 0, 100
+	for name, target := range map[string]*int{"offset": &offset, "limit": &limit} {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("invalid %s: '%s'", name, value))
+			return 0, 0, false
+		}
+		*target = number
+	}
+	return offset, min(limit, 1000), true
+}
+
+// page returns the items in the offset and limit bounds.
+func page[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	return items[offset:min(offset+limit, len(items))]
+}
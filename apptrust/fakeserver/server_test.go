@@ -0,0 +1,299 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/applications"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/packages"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/systems"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/versions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPackage = Package{
+	Type:          "npm",
+	Name:          "pizza-frontend",
+	Version:       "1.0.0",
+	RepositoryKey: "npm-local",
+	Artifacts:     []model.ReleasableArtifact{{Path: "pizza-frontend/-/pizza-frontend-1.0.0.tgz", SHA256: "abc123"}},
+}
+
+// startServer starts a server seeded with the "app" application and the test package,
+// and returns it with a context for the CLI services.
+func startServer(t *testing.T, options ...Option) (*Server, service.Context) {
+	s, serverDetails := StartTestServer(t, options...)
+	require.NoError(t, s.Seed(SeedData{
+		Applications: []model.AppDescriptor{{ApplicationKey: "app", ProjectKey: "proj"}},
+		Packages:     []Package{testPackage},
+	}))
+	ctx, err := service.NewContext(*serverDetails, apphttp.WithRetries(0))
+	require.NoError(t, err)
+	return s, ctx
+}
+
+func packageSources() *model.CreateVersionSources {
+	return &model.CreateVersionSources{Packages: []model.CreateVersionPackage{
+		{Type: testPackage.Type, Name: testPackage.Name, Version: testPackage.Version, Repository: testPackage.RepositoryKey},
+	}}
+}
+
+func assertStatusCode(t *testing.T, expected int, err error) {
+	var apptrustErr *apphttp.ApptrustError
+	require.True(t, errors.As(err, &apptrustErr), "expected an ApptrustError, got: %v", err)
+	assert.Equal(t, expected, apptrustErr.StatusCode)
+}
+
+func TestPing(t *testing.T) {
+	_, ctx := startServer(t)
+	body, err := systems.NewSystemService().Ping(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", body)
+}
+
+func TestGetVersion(t *testing.T) {
+	_, ctx := startServer(t)
+	version, err := systems.NewSystemService().GetVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, Version, version.Version)
+}
+
+func TestUnauthorized(t *testing.T) {
+	_, serverDetails := StartTestServer(t, WithAccessToken("secret"))
+	serverDetails.AccessToken = "wrong"
+	ctx, err := service.NewContext(*serverDetails, apphttp.WithRetries(0))
+	require.NoError(t, err)
+
+	_, err = systems.NewSystemService().Ping(ctx)
+	assertStatusCode(t, http.StatusUnauthorized, err)
+}
+
+func TestApplications(t *testing.T) {
+	_, ctx := startServer(t)
+	appService := applications.NewApplicationService()
+	maturity := model.MaturityLevelProduction
+
+	created, err := appService.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", ApplicationName: "Web", ProjectKey: "proj", MaturityLevel: &maturity})
+	require.NoError(t, err)
+	assert.Equal(t, "Web", created.ApplicationName)
+
+	_, err = appService.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", ApplicationName: "Web", ProjectKey: "proj"})
+	assertStatusCode(t, http.StatusConflict, err)
+	_, err = appService.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "Web", ApplicationName: "Web", ProjectKey: "proj"})
+	assertStatusCode(t, http.StatusBadRequest, err)
+	_, err = appService.CreateApplication(ctx, &model.AppDescriptor{ApplicationKey: "api", ProjectKey: "proj"})
+	assertStatusCode(t, http.StatusBadRequest, err)
+
+	description := "The web application"
+	updated, err := appService.UpdateApplication(ctx, &model.AppDescriptor{ApplicationKey: "web", Description: &description})
+	require.NoError(t, err)
+	assert.Equal(t, &description, updated.Description)
+	assert.Equal(t, &maturity, updated.MaturityLevel)
+
+	apps, err := apphttp.Collect(appService.ListApplications(ctx, &model.ListApplicationsRequest{MaturityLevel: maturity}))
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "web", apps[0].ApplicationKey)
+
+	require.NoError(t, appService.DeleteApplication(ctx, "web"))
+	_, err = appService.GetApplication(ctx, "web")
+	assertStatusCode(t, http.StatusNotFound, err)
+}
+
+func TestListApplications_Pagination(t *testing.T) {
+	s, ctx := startServer(t)
+	var seed SeedData
+	for _, key := range []string{"app-1", "app-2", "app-3", "app-4", "app-5"} {
+		seed.Applications = append(seed.Applications, model.AppDescriptor{ApplicationKey: key, ProjectKey: "proj"})
+	}
+	require.NoError(t, s.Seed(seed))
+
+	decode := func(body []byte) (*apphttp.Page[model.AppDescriptor], error) {
+		var response model.ListApplicationsResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+		return &apphttp.Page[model.AppDescriptor]{Items: response.Applications, Total: response.Total}, nil
+	}
+	var keys []string
+	for app, err := range apphttp.Paginate(ctx.GetHttpClient(), "/v1/applications", apphttp.PageOptions{PageSize: 2}, decode) {
+		require.NoError(t, err)
+		keys = append(keys, app.ApplicationKey)
+	}
+	assert.Equal(t, []string{"app", "app-1", "app-2", "app-3", "app-4", "app-5"}, keys)
+}
+
+func TestCreateVersion(t *testing.T) {
+	_, ctx := startServer(t)
+	versionService := versions.NewVersionService()
+
+	response, err := versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.0", Sources: packageSources()}, true, false)
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusCompleted, response.Status)
+
+	content, err := versionService.GetAppVersion(ctx, "app", "1.0.0", true)
+	require.NoError(t, err)
+	require.Len(t, content.Releasables, 1)
+	assert.Equal(t, testPackage.Artifacts, content.Releasables[0].Artifacts)
+
+	_, err = versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.0", Sources: packageSources()}, true, false)
+	assertStatusCode(t, http.StatusConflict, err)
+
+	_, err = versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.1",
+		Sources: &model.CreateVersionSources{Builds: []model.CreateVersionBuild{{Name: "missing", Number: "1"}}}}, true, false)
+	assertStatusCode(t, http.StatusUnprocessableEntity, err)
+
+	_, err = versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.2", Sources: packageSources()}, true, true)
+	require.NoError(t, err)
+	_, err = versionService.GetAppVersion(ctx, "app", "1.0.2", false)
+	assertStatusCode(t, http.StatusNotFound, err)
+}
+
+func TestCreateVersion_Async(t *testing.T) {
+	s, ctx := startServer(t, WithAsyncDelay(time.Minute))
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	versionService := versions.NewVersionService()
+
+	response, err := versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.0", Sources: packageSources()}, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusStarted, response.Status)
+
+	_, err = versionService.PromoteAppVersion(ctx, "app", "1.0.0", &model.PromoteAppVersionRequest{Stage: "DEV"}, true)
+	assertStatusCode(t, http.StatusConflict, err)
+
+	now = now.Add(time.Minute)
+	content, err := versionService.GetAppVersion(ctx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusCompleted, content.Status)
+	assert.Len(t, content.Releasables, 1)
+}
+
+func TestListVersions_Filters(t *testing.T) {
+	_, ctx := startServer(t)
+	versionService := versions.NewVersionService()
+	for _, version := range []string{"1.0.0", "1.1.0", "2.0.0"} {
+		_, err := versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: version, Sources: packageSources()}, true, false)
+		require.NoError(t, err)
+	}
+	_, err := versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "3.0.0", Draft: true}, true, false)
+	require.NoError(t, err)
+	_, err = versionService.PromoteAppVersion(ctx, "app", "1.1.0", &model.PromoteAppVersionRequest{Stage: "QA"}, true)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		params   map[string]string
+		expected []string
+	}{
+		{name: "newest first", params: map[string]string{}, expected: []string{"3.0.0", "2.0.0", "1.1.0", "1.0.0"}},
+		{name: "oldest first", params: map[string]string{"sort_by": "created", "order": "asc"}, expected: []string{"1.0.0", "1.1.0", "2.0.0", "3.0.0"}},
+		{name: "stage", params: map[string]string{"stage": "qa"}, expected: []string{"1.1.0"}},
+		{name: "drafts", params: map[string]string{"draft": "true"}, expected: []string{"3.0.0"}},
+		{name: "no drafts", params: map[string]string{"draft": "false"}, expected: []string{"2.0.0", "1.1.0", "1.0.0"}},
+		{name: "created before", params: map[string]string{"created_before": "2000-01-01T00:00:00Z"}, expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, body, err := ctx.GetHttpClient().Get("/v1/applications/app/versions", tt.params)
+			require.NoError(t, err)
+			var response model.ListAppVersionsResponse
+			require.NoError(t, json.Unmarshal(body, &response))
+			names := []string{}
+			for _, appVersion := range response.Versions {
+				names = append(names, appVersion.Version)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+
+	resp, _, err := ctx.GetHttpClient().Get("/v1/applications/app/versions", map[string]string{"sort_by": "version"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestUpdateDraftVersionSources(t *testing.T) {
+	_, ctx := startServer(t)
+	versionService := versions.NewVersionService()
+
+	response, err := versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.0", Draft: true}, true, false)
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusDraft, response.Status)
+
+	_, err = versionService.UpdateAppVersionSources(ctx, "app", "1.0.0",
+		&model.UpdateVersionSourcesRequest{AddSources: packageSources()}, true, false, false)
+	require.NoError(t, err)
+
+	content, err := versionService.GetAppVersion(ctx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusDraft, content.Status)
+	require.Len(t, content.Releasables, 1)
+	assert.Equal(t, testPackage.Name, content.Releasables[0].Name)
+	assert.Empty(t, content.Releasables[0].Artifacts)
+}
+
+func TestVersionLifecycle(t *testing.T) {
+	_, ctx := startServer(t)
+	versionService := versions.NewVersionService()
+	_, err := versionService.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "app", Version: "1.0.0", Sources: packageSources()}, true, false)
+	require.NoError(t, err)
+
+	promotion, err := versionService.PromoteAppVersion(ctx, "app", "1.0.0", &model.PromoteAppVersionRequest{Stage: "QA"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, model.VersionStatusCompleted, promotion.Status)
+	_, err = versionService.ReleaseAppVersion(ctx, "app", "1.0.0", &model.ReleaseAppVersionRequest{}, true)
+	require.NoError(t, err)
+
+	content, err := versionService.GetAppVersion(ctx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	assert.Equal(t, ReleaseStage, content.CurrentStage)
+	assert.Equal(t, model.ReleaseStatusReleased, content.ReleaseStatus)
+
+	_, err = versionService.RollbackAppVersion(ctx, "app", "1.0.0", model.NewRollbackAppVersionRequest("QA"), true)
+	assertStatusCode(t, http.StatusBadRequest, err)
+	rollback, err := versionService.RollbackAppVersion(ctx, "app", "1.0.0", model.NewRollbackAppVersionRequest(ReleaseStage), true)
+	require.NoError(t, err)
+	assert.Equal(t, "QA", rollback.RollbackToStage)
+
+	content, err = versionService.GetAppVersion(ctx, "app", "1.0.0", false)
+	require.NoError(t, err)
+	assert.Equal(t, "QA", content.CurrentStage)
+	assert.Equal(t, model.ReleaseStatusPreRelease, content.ReleaseStatus)
+
+	events, err := versionService.GetAppVersionHistory(ctx, "app", "1.0.0")
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for _, event := range events {
+		assert.Equal(t, model.VersionStatusCompleted, event.Status)
+	}
+
+	appService := applications.NewApplicationService()
+	assertStatusCode(t, http.StatusConflict, appService.DeleteApplication(ctx, "app"))
+	require.NoError(t, versionService.DeleteAppVersion(ctx, "app", "1.0.0"))
+	assert.NoError(t, appService.DeleteApplication(ctx, "app"))
+}
+
+func TestPackageBindings(t *testing.T) {
+	_, ctx := startServer(t)
+	packageService := packages.NewPackageService()
+	for _, version := range []string{"1.2.0", "1.10.0", "1.9.0"} {
+		_, err := packageService.BindPackage(ctx, "app", &model.BindPackageRequest{Type: "npm", Name: "@scope/web", Version: version})
+		require.NoError(t, err)
+	}
+	_, err := packageService.BindPackage(ctx, "app", &model.BindPackageRequest{Type: "npm", Name: "@scope/web", Version: "1.2.0"})
+	assertStatusCode(t, http.StatusConflict, err)
+
+	bindings, err := apphttp.Collect(packageService.ListBoundPackages(ctx, "app", &model.ListBoundPackagesRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, []model.PackageBinding{{Type: "npm", Name: "@scope/web", NumVersions: 3, LatestVersion: "1.10.0"}}, bindings)
+
+	require.NoError(t, packageService.UnbindPackage(ctx, "app", "npm", "@scope/web", "1.10.0"))
+	assertStatusCode(t, http.StatusNotFound, packageService.UnbindPackage(ctx, "app", "npm", "@scope/web", "1.10.0"))
+}
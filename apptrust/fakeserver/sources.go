@@ -0,0 +1,260 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+// Package is a package in the catalog, with the artifacts it consists of.
+type Package struct {
+	Type          string                     `json:"type"`
+	Name          string                     `json:"name"`
+	Version       string                     `json:"version"`
+	RepositoryKey string                     `json:"repository_key,omitempty"`
+	Artifacts     []model.ReleasableArtifact `json:"artifacts,omitempty"`
+}
+
+// Build is a build in the catalog, with the packages it produced.
+type Build struct {
+	Name     string    `json:"name"`
+	Number   string    `json:"number"`
+	Packages []Package `json:"packages"`
+}
+
+// ReleaseBundle is a release bundle version in the catalog, with the packages it contains.
+type ReleaseBundle struct {
+	ProjectKey string    `json:"project_key,omitempty"`
+	Name       string    `json:"name"`
+	Version    string    `json:"version"`
+	Packages   []Package `json:"packages"`
+}
+
+// SeedData is the initial state of the server: applications, and the catalog that version sources are resolved against.
+type SeedData struct {
+	Applications   []model.AppDescriptor `json:"applications,omitempty"`
+	Packages       []Package             `json:"packages,omitempty"`
+	Builds         []Build               `json:"builds,omitempty"`
+	ReleaseBundles []ReleaseBundle       `json:"release_bundles,omitempty"`
+}
+
+// LoadSeedFile reads SeedData from a JSON file.
+func LoadSeedFile(filePath string) (*SeedData, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	seed := new(SeedData)
+	if err = json.Unmarshal(content, seed); err != nil {
+		return nil, fmt.Errorf("failed to parse the seed file %s: %w", filePath, err)
+	}
+	return seed, nil
+}
+
+type catalog struct {
+	packages       []Package
+	builds         map[string]Build
+	releaseBundles map[string]ReleaseBundle
+}
+
+func newCatalog() catalog {
+	return catalog{builds: map[string]Build{}, releaseBundles: map[string]ReleaseBundle{}}
+}
+
+// Seed adds applications and catalog entries to the server. Applications that already exist are replaced.
+func (s *Server) Seed(seed SeedData) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i := range seed.Applications {
+		descriptor := seed.Applications[i]
+		if message := validateApplication(&descriptor); message != "" {
+			return fmt.Errorf("invalid application '%s': %s", descriptor.ApplicationKey, message)
+		}
+		s.applications[descriptor.ApplicationKey] = &application{
+			descriptor: descriptor,
+			versions:   map[string]*appVersion{},
+			packages:   map[string][]string{},
+		}
+	}
+	s.catalog.packages = append(s.catalog.packages, seed.Packages...)
+	for _, build := range seed.Builds {
+		s.catalog.packages = append(s.catalog.packages, build.Packages...)
+		s.catalog.builds[build.Name+"/"+build.Number] = build
+	}
+	for _, bundle := range seed.ReleaseBundles {
+		s.catalog.packages = append(s.catalog.packages, bundle.Packages...)
+		s.catalog.releaseBundles[bundle.ProjectKey+"/"+bundle.Name+"/"+bundle.Version] = bundle
+	}
+	return nil
+}
+
+// findPackage returns the catalog package with the given coordinates. The repository is ignored if it is empty.
+func (c *catalog) findPackage(packageType, name, version, repositoryKey string) (Package, bool) {
+	for _, pkg := range c.packages {
+		if pkg.Type == packageType && pkg.Name == name && pkg.Version == version &&
+			(repositoryKey == "" || pkg.RepositoryKey == "" || pkg.RepositoryKey == repositoryKey) {
+			return pkg, true
+		}
+	}
+	return Package{}, false
+}
+
+// findArtifact returns the catalog package that contains the artifact at the given path.
+func (c *catalog) findArtifact(artifactPath string) (Package, model.ReleasableArtifact, bool) {
+	for _, pkg := range c.packages {
+		for _, artifact := range pkg.Artifacts {
+			if artifact.Path == artifactPath || path.Join(pkg.RepositoryKey, artifact.Path) == artifactPath {
+				return pkg, artifact, true
+			}
+		}
+	}
+	return Package{}, model.ReleasableArtifact{}, false
+}
+
+// sourceError is a version source that can't be resolved.
+type sourceError struct {
+	message string
+}
+
+func (e *sourceError) Error() string {
+	return e.message
+}
+
+// resolveSources returns the releasables of the version sources, after the filters are applied.
+// The caller must hold the mutex.
+func (s *Server) resolveSources(applicationKey string, sources *model.CreateVersionSources, filters *model.CreateVersionFilters) ([]model.Releasable, error) {
+	if sources == nil {
+		return []model.Releasable{}, nil
+	}
+	var releasables []model.Releasable
+	add := func(releasable model.Releasable) {
+		for i := range releasables {
+			existing := &releasables[i]
+			if existing.PackageType == releasable.PackageType && existing.Name == releasable.Name && existing.Version == releasable.Version {
+				existing.Sources = append(existing.Sources, releasable.Sources...)
+				return
+			}
+		}
+		releasables = append(releasables, releasable)
+	}
+
+	for _, source := range sources.Packages {
+		pkg, found := s.catalog.findPackage(source.Type, source.Name, source.Version, source.Repository)
+		if !found {
+			pkg = Package{Type: source.Type, Name: source.Name, Version: source.Version, RepositoryKey: source.Repository}
+		}
+		add(toReleasable(pkg, model.ReleasableSource{Type: "package", Name: source.Name, Version: source.Version, RepositoryKey: source.Repository}))
+	}
+	for _, source := range sources.Artifacts {
+		releasableSource := model.ReleasableSource{Type: "artifact", Name: source.Path}
+		if pkg, artifact, found := s.catalog.findArtifact(source.Path); found {
+			pkg.Artifacts = []model.ReleasableArtifact{artifact}
+			add(toReleasable(pkg, releasableSource))
+			continue
+		}
+		add(model.Releasable{
+			Name:        path.Base(source.Path),
+			PackageType: "generic",
+			SHA256:      source.SHA256,
+			Sources:     []model.ReleasableSource{releasableSource},
+			Artifacts:   []model.ReleasableArtifact{{Path: source.Path, SHA256: source.SHA256}},
+		})
+	}
+	for _, source := range sources.Builds {
+		build, found := s.catalog.builds[source.Name+"/"+source.Number]
+		if !found {
+			return nil, &sourceError{fmt.Sprintf("build '%s' number '%s' was not found", source.Name, source.Number)}
+		}
+		for _, pkg := range build.Packages {
+			add(toReleasable(pkg, model.ReleasableSource{Type: "build", Name: source.Name, Version: source.Number, RepositoryKey: source.RepositoryKey}))
+		}
+	}
+	for _, source := range sources.ReleaseBundles {
+		bundle, found := s.catalog.releaseBundles[source.ProjectKey+"/"+source.Name+"/"+source.Version]
+		if !found {
+			return nil, &sourceError{fmt.Sprintf("release bundle '%s' version '%s' was not found", source.Name, source.Version)}
+		}
+		for _, pkg := range bundle.Packages {
+			add(toReleasable(pkg, model.ReleasableSource{Type: "release_bundle", Name: source.Name, Version: source.Version, ProjectKey: source.ProjectKey}))
+		}
+	}
+	for _, source := range sources.Versions {
+		sourceApplicationKey := source.ApplicationKey
+		if sourceApplicationKey == "" {
+			sourceApplicationKey = applicationKey
+		}
+		sourceVersion := s.findVersion(sourceApplicationKey, source.Version)
+		if sourceVersion == nil {
+			return nil, &sourceError{fmt.Sprintf("application version '%s:%s' was not found", sourceApplicationKey, source.Version)}
+		}
+		for _, releasable := range sourceVersion.releasables {
+			releasable.Sources = []model.ReleasableSource{{Type: "application_version", Name: sourceApplicationKey, Version: source.Version}}
+			add(releasable)
+		}
+	}
+	return applyFilters(releasables, filters), nil
+}
+
+func toReleasable(pkg Package, source model.ReleasableSource) model.Releasable {
+	releasable := model.Releasable{
+		Name:          pkg.Name,
+		Version:       pkg.Version,
+		PackageType:   pkg.Type,
+		RepositoryKey: pkg.RepositoryKey,
+		Sources:       []model.ReleasableSource{source},
+		Artifacts:     slices.Clone(pkg.Artifacts),
+	}
+	if len(pkg.Artifacts) == 1 {
+		releasable.SHA256 = pkg.Artifacts[0].SHA256
+	}
+	return releasable
+}
+
+// applyFilters keeps the releasables that match one of the included filters, if any, and none of the excluded filters.
+func applyFilters(releasables []model.Releasable, filters *model.CreateVersionFilters) []model.Releasable {
+	filtered := []model.Releasable{}
+	for _, releasable := range releasables {
+		if filters == nil {
+			filtered = append(filtered, releasable)
+			continue
+		}
+		included := len(filters.Included) == 0 || slices.ContainsFunc(filters.Included, func(filter *model.CreateVersionSourceFilter) bool {
+			return matchesFilter(releasable, filter)
+		})
+		excluded := slices.ContainsFunc(filters.Excluded, func(filter *model.CreateVersionSourceFilter) bool {
+			return matchesFilter(releasable, filter)
+		})
+		if included && !excluded {
+			filtered = append(filtered, releasable)
+		}
+	}
+	return filtered
+}
+
+// matchesFilter reports whether the releasable matches all the fields set in the filter. Fields are glob patterns.
+func matchesFilter(releasable model.Releasable, filter *model.CreateVersionSourceFilter) bool {
+	if filter == nil {
+		return false
+	}
+	matches := func(pattern, value string) bool {
+		if pattern == "" {
+			return true
+		}
+		matched, err := path.Match(pattern, value)
+		return err == nil && matched
+	}
+	if !matches(filter.PackageType, releasable.PackageType) || !matches(filter.PackageName, releasable.Name) ||
+		!matches(filter.PackageVersion, releasable.Version) || !matches(filter.SHA256, releasable.SHA256) {
+		return false
+	}
+	if filter.Path == "" {
+		return true
+	}
+	return slices.ContainsFunc(releasable.Artifacts, func(artifact model.ReleasableArtifact) bool {
+		return matches(filter.Path, artifact.Path)
+	})
+}
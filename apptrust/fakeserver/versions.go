@@ -0,0 +1,552 @@
+package fakeserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+// ReleaseStage is the stage that versions are promoted to when they are released.
+const ReleaseStage = "PROD"
+
+type appVersion struct {
+	applicationKey string
+	projectKey     string
+	version        string
+	sequence       int
+	tag            string
+	status         string
+	releaseStatus  string
+	currentStage   string
+	// previousStages holds the stages the version was promoted from, for rollbacks.
+	previousStages []string
+	createdBy      string
+	created        string
+	properties     map[string][]string
+	releasables    []model.Releasable
+	events         []model.VersionHistoryEvent
+
+	// pending completes the operation in progress, once readyAt has passed.
+	pending func()
+	readyAt time.Time
+}
+
+// findVersion returns the version with its pending operation completed if it is due, or nil if it does not exist.
+// The caller must hold the mutex.
+func (s *Server) findVersion(applicationKey, version string) *appVersion {
+	app, ok := s.applications[applicationKey]
+	if !ok {
+		return nil
+	}
+	v, ok := app.versions[version]
+	if !ok {
+		return nil
+	}
+	if v.pending != nil && !s.now().Before(v.readyAt) {
+		v.pending()
+		v.pending = nil
+	}
+	return v
+}
+
+// lookupVersion returns the application and version named in the request path, and writes a 404 error if either
+// does not exist. The caller must hold the mutex.
+func (s *Server) lookupVersion(w http.ResponseWriter, r *http.Request) (*application, *appVersion, bool) {
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	v := s.findVersion(app.descriptor.ApplicationKey, r.PathValue("version"))
+	if v == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND",
+			fmt.Sprintf("version '%s' of application '%s' was not found", r.PathValue("version"), app.descriptor.ApplicationKey))
+		return nil, nil, false
+	}
+	return app, v, true
+}
+
+// lookupIdleVersion is like lookupVersion, and also writes a 409 error if an operation on the version is in progress.
+func (s *Server) lookupIdleVersion(w http.ResponseWriter, r *http.Request) (*appVersion, bool) {
+	_, v, ok := s.lookupVersion(w, r)
+	if !ok {
+		return nil, false
+	}
+	if v.pending != nil {
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("an operation on version '%s' is in progress", v.version))
+		return nil, false
+	}
+	return v, true
+}
+
+// run applies the operation, or schedules it if the request is asynchronous, and returns the status to report.
+// The version reaches finalStatus when the operation completes.
+func (s *Server) run(v *appVersion, async bool, finalStatus string, apply func()) string {
+	if !async {
+		apply()
+		v.status = finalStatus
+		return finalStatus
+	}
+	v.status = model.VersionStatusStarted
+	v.readyAt = s.now().Add(s.asyncDelay)
+	v.pending = func() {
+		apply()
+		v.status = finalStatus
+	}
+	return model.VersionStatusStarted
+}
+
+func (v *appVersion) response(status string) model.AppVersionResponse {
+	return model.AppVersionResponse{
+		ApplicationKey: v.applicationKey,
+		Version:        v.version,
+		ProjectKey:     v.projectKey,
+		Status:         status,
+		Tag:            v.tag,
+		CreatedBy:      v.createdBy,
+		Created:        v.created,
+	}
+}
+
+// writeSourceError writes a 422 error for sources that can't be resolved, and a 500 error otherwise.
+func writeSourceError(w http.ResponseWriter, err error) {
+	var sourceErr *sourceError
+	if errors.As(err, &sourceErr) {
+		writeError(w, http.StatusUnprocessableEntity, "INVALID_SOURCE", sourceErr.message)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+}
+
+func (s *Server) createVersion(w http.ResponseWriter, r *http.Request) {
+	var request model.CreateAppVersionRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	async, dryRun := boolParam(r, "async", true), boolParam(r, "dry_run", false)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return
+	}
+	switch {
+	case request.ApplicationKey != "" && request.ApplicationKey != app.descriptor.ApplicationKey:
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "the application key in the body does not match the path")
+		return
+	case request.Version == "":
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "version is required")
+		return
+	case !request.Draft && !hasSources(request.Sources):
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "at least one source is required")
+		return
+	}
+	if _, exists := app.versions[request.Version]; exists {
+		writeError(w, http.StatusConflict, "ALREADY_EXISTS",
+			fmt.Sprintf("version '%s' of application '%s' already exists", request.Version, app.descriptor.ApplicationKey))
+		return
+	}
+	releasables, err := s.resolveSources(app.descriptor.ApplicationKey, request.Sources, request.Filters)
+	if err != nil {
+		writeSourceError(w, err)
+		return
+	}
+
+	s.versionSequence++
+	v := &appVersion{
+		applicationKey: app.descriptor.ApplicationKey,
+		projectKey:     app.descriptor.ProjectKey,
+		version:        request.Version,
+		sequence:       s.versionSequence,
+		tag:            request.Tag,
+		releaseStatus:  model.ReleaseStatusPreRelease,
+		createdBy:      s.user,
+		created:        s.timestamp(),
+		properties:     map[string][]string{},
+	}
+	if dryRun {
+		v.releasables = releasables
+		writeJson(w, http.StatusOK, v.response(model.VersionStatusCompleted))
+		return
+	}
+	app.versions[request.Version] = v
+
+	if request.Draft {
+		v.releasables = releasables
+		v.status = model.VersionStatusDraft
+		writeJson(w, http.StatusCreated, v.response(v.status))
+		return
+	}
+	status := s.run(v, async, model.VersionStatusCompleted, func() { v.releasables = releasables })
+	writeJson(w, statusCode(async, http.StatusCreated), v.response(status))
+}
+
+func hasSources(sources *model.CreateVersionSources) bool {
+	return sources != nil && (len(sources.Artifacts) > 0 || len(sources.Packages) > 0 || len(sources.Builds) > 0 ||
+		len(sources.ReleaseBundles) > 0 || len(sources.Versions) > 0)
+}
+
+// statusCode returns 202 Accepted for asynchronous requests, and syncStatusCode otherwise.
+func statusCode(async bool, syncStatusCode int) int {
+	if async {
+		return http.StatusAccepted
+	}
+	return syncStatusCode
+}
+
+func (s *Server) listVersions(w http.ResponseWriter, r *http.Request) {
+	offset, limit, ok := pageBounds(w, r)
+	if !ok {
+		return
+	}
+	filters, ok := parseVersionFilters(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	if sortBy := query.Get("sort_by"); sortBy != "" && sortBy != model.VersionSortByCreated {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("unsupported sort_by: '%s'", sortBy))
+		return
+	}
+	ascending := query.Get("order") == model.SortOrderAsc
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, ok := s.lookupApplication(w, r)
+	if !ok {
+		return
+	}
+	var versions []*appVersion
+	for version := range app.versions {
+		versions = append(versions, s.findVersion(app.descriptor.ApplicationKey, version))
+	}
+	// The newest versions are listed first, unless the ascending order is asked for.
+	sort.Slice(versions, func(i, j int) bool {
+		if ascending {
+			return versions[i].sequence < versions[j].sequence
+		}
+		return versions[i].sequence > versions[j].sequence
+	})
+	appVersions := []model.AppVersion{}
+	for _, v := range versions {
+		if !filters.matches(v) {
+			continue
+		}
+		appVersions = append(appVersions, model.AppVersion{
+			Version:       v.version,
+			Tag:           v.tag,
+			Status:        v.status,
+			ReleaseStatus: v.releaseStatus,
+			CurrentStage:  v.currentStage,
+			CreatedBy:     v.createdBy,
+			Created:       v.created,
+		})
+	}
+	writeJson(w, http.StatusOK, model.ListAppVersionsResponse{
+		Versions: page(appVersions, offset, limit),
+		Offset:   offset,
+		Limit:    limit,
+		Total:    len(appVersions),
+	})
+}
+
+// versionFilters holds the query filters of the list versions API.
+type versionFilters struct {
+	stage         string
+	tag           string
+	releaseStatus string
+	draft         string
+	createdAfter  time.Time
+	createdBefore time.Time
+}
+
+func parseVersionFilters(w http.ResponseWriter, r *http.Request) (filters versionFilters, ok bool) {
+	query := r.URL.Query()
+	filters = versionFilters{
+		stage:         query.Get("stage"),
+		tag:           query.Get("tag"),
+		releaseStatus: query.Get("release_status"),
+		draft:         query.Get("draft"),
+	}
+	if filters.draft != "" && filters.draft != "true" && filters.draft != "false" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("invalid draft: '%s'", filters.draft))
+		return versionFilters{}, false
+	}
+	for name, target := range map[string]*time.Time{"created_after": &filters.createdAfter, "created_before": &filters.createdBefore} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("invalid %s: '%s'", name, value))
+			return versionFilters{}, false
+		}
+		*target = parsed
+	}
+	return filters, true
+}
+
+func (f versionFilters) matches(v *appVersion) bool {
+	if f.stage != "" && !strings.EqualFold(v.currentStage, f.stage) {
+		return false
+	}
+	if f.tag != "" && v.tag != f.tag {
+		return false
+	}
+	if f.releaseStatus != "" && !strings.EqualFold(v.releaseStatus, f.releaseStatus) {
+		return false
+	}
+	if f.draft != "" && (f.draft == "true") != (v.status == model.VersionStatusDraft) {
+		return false
+	}
+	if f.createdAfter.IsZero() && f.createdBefore.IsZero() {
+		return true
+	}
+	created, err := time.Parse(time.RFC3339, v.created)
+	if err != nil {
+		return false
+	}
+	return !created.Before(f.createdAfter) && (f.createdBefore.IsZero() || !created.After(f.createdBefore))
+}
+
+func (s *Server) getVersionContent(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, v, ok := s.lookupVersion(w, r)
+	if !ok {
+		return
+	}
+	// Artifacts are listed only in the expanded releasables.
+	expanded := r.URL.Query().Get("include") == "releasables_expanded"
+	releasables := []model.Releasable{}
+	for _, releasable := range v.releasables {
+		if !expanded {
+			releasable.Artifacts = nil
+		}
+		releasables = append(releasables, releasable)
+	}
+	writeJson(w, http.StatusOK, model.VersionContentResponse{
+		ApplicationKey: v.applicationKey,
+		Version:        v.version,
+		Status:         v.status,
+		ReleaseStatus:  v.releaseStatus,
+		CurrentStage:   v.currentStage,
+		Tag:            v.tag,
+		CreatedBy:      v.createdBy,
+		Created:        v.created,
+		Properties:     v.properties,
+		Releasables:    releasables,
+	})
+}
+
+// updateVersion handles both the update of the version tag and properties, and the update of the sources of a draft.
+func (s *Server) updateVersion(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		model.UpdateAppVersionRequest
+		model.UpdateVersionSourcesRequest
+	}
+	if !decodeBody(w, r, &request) {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	v, ok := s.lookupIdleVersion(w, r)
+	if !ok {
+		return
+	}
+
+	if request.AddSources == nil && request.Filters == nil {
+		if request.Tag != "" {
+			v.tag = request.Tag
+		}
+		for key, values := range request.Properties {
+			v.properties[key] = values
+		}
+		for _, key := range request.DeleteProperties {
+			delete(v.properties, key)
+		}
+		writeJson(w, http.StatusOK, v.response(v.status))
+		return
+	}
+
+	if v.status != model.VersionStatusDraft {
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("the sources of version '%s' can't be updated, since it is not a draft", v.version))
+		return
+	}
+	added, err := s.resolveSources(v.applicationKey, request.AddSources, nil)
+	if err != nil {
+		writeSourceError(w, err)
+		return
+	}
+	releasables := applyFilters(append(append([]model.Releasable{}, v.releasables...), added...), request.Filters)
+	async := boolParam(r, "async", true)
+	if boolParam(r, "dry_run", false) {
+		writeJson(w, http.StatusOK, v.response(v.status))
+		return
+	}
+	status := s.run(v, async, model.VersionStatusDraft, func() { v.releasables = releasables })
+	writeJson(w, statusCode(async, http.StatusOK), v.response(status))
+}
+
+func (s *Server) deleteVersion(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	app, v, ok := s.lookupVersion(w, r)
+	if !ok {
+		return
+	}
+	delete(app.versions, v.version)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) promoteVersion(w http.ResponseWriter, r *http.Request) {
+	var request model.PromoteAppVersionRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	if request.Stage == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "target_stage is required")
+		return
+	}
+	s.movement(w, r, model.VersionEventPromotion, request.Stage, request.CommonPromoteAppVersion)
+}
+
+func (s *Server) releaseVersion(w http.ResponseWriter, r *http.Request) {
+	var request model.ReleaseAppVersionRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	s.movement(w, r, model.VersionEventRelease, ReleaseStage, request.CommonPromoteAppVersion)
+}
+
+// movement promotes or releases a version to the target stage.
+func (s *Server) movement(w http.ResponseWriter, r *http.Request, eventType, targetStage string, request model.CommonPromoteAppVersion) {
+	async := boolParam(r, "async", true)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	v, ok := s.lookupIdleVersion(w, r)
+	if !ok {
+		return
+	}
+	switch {
+	case v.status != model.VersionStatusCompleted:
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("version '%s' can't be moved to a stage while its status is %s", v.version, v.status))
+		return
+	case strings.EqualFold(v.currentStage, targetStage):
+		writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("version '%s' is already in stage '%s'", v.version, targetStage))
+		return
+	}
+
+	response := model.PromoteAppVersionResponse{
+		ApplicationKey: v.applicationKey,
+		Version:        v.version,
+		ProjectKey:     v.projectKey,
+		SourceStage:    v.currentStage,
+		TargetStage:    targetStage,
+		PromotionType:  request.PromotionType,
+		CreatedBy:      s.user,
+		Created:        s.timestamp(),
+	}
+	if request.PromotionType == model.PromotionTypeDryRun {
+		response.Status = model.VersionStatusCompleted
+		writeJson(w, http.StatusOK, response)
+		return
+	}
+
+	event := s.addEvent(v, model.VersionHistoryEvent{
+		EventType:     eventType,
+		SourceStage:   v.currentStage,
+		TargetStage:   targetStage,
+		PromotionType: request.PromotionType,
+	})
+	response.Status = s.run(v, async, model.VersionStatusCompleted, func() {
+		v.previousStages = append(v.previousStages, v.currentStage)
+		v.currentStage = targetStage
+		if eventType == model.VersionEventRelease {
+			v.releaseStatus = model.ReleaseStatusReleased
+		}
+		v.events[event].Status = model.VersionStatusCompleted
+	})
+	v.events[event].Status = response.Status
+	writeJson(w, statusCode(async, http.StatusOK), response)
+}
+
+func (s *Server) rollbackVersion(w http.ResponseWriter, r *http.Request) {
+	var request model.RollbackAppVersionRequest
+	if !decodeBody(w, r, &request) {
+		return
+	}
+	async := boolParam(r, "async", true)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	v, ok := s.lookupIdleVersion(w, r)
+	if !ok {
+		return
+	}
+	if request.FromStage == "" || !strings.EqualFold(request.FromStage, v.currentStage) || len(v.previousStages) == 0 {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST",
+			fmt.Sprintf("version '%s' can't be rolled back from stage '%s', since its current stage is '%s'", v.version, request.FromStage, v.currentStage))
+		return
+	}
+
+	previousStage := v.previousStages[len(v.previousStages)-1]
+	event := s.addEvent(v, model.VersionHistoryEvent{
+		EventType:   model.VersionEventRollback,
+		SourceStage: v.currentStage,
+		TargetStage: previousStage,
+	})
+	response := model.RollbackAppVersionResponse{
+		ApplicationKey:    v.applicationKey,
+		Version:           v.version,
+		ProjectKey:        v.projectKey,
+		RollbackFromStage: v.currentStage,
+		RollbackToStage:   previousStage,
+	}
+	v.events[event].Status = s.run(v, async, model.VersionStatusCompleted, func() {
+		if strings.EqualFold(v.currentStage, ReleaseStage) {
+			v.releaseStatus = model.ReleaseStatusPreRelease
+		}
+		v.previousStages = v.previousStages[:len(v.previousStages)-1]
+		v.currentStage = previousStage
+		v.events[event].Status = model.VersionStatusCompleted
+	})
+	writeJson(w, statusCode(async, http.StatusOK), response)
+}
+
+// addEvent adds an event to the version history, and returns its index.
+func (s *Server) addEvent(v *appVersion, event model.VersionHistoryEvent) int {
+	event.CreatedBy = s.user
+	event.Created = s.timestamp()
+	v.events = append(v.events, event)
+	return len(v.events) - 1
+}
+
+func (s *Server) getVersionHistory(w http.ResponseWriter, r *http.Request) {
+	offset, limit, ok := pageBounds(w, r)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, v, ok := s.lookupVersion(w, r)
+	if !ok {
+		return
+	}
+	events := append([]model.VersionHistoryEvent{}, v.events...)
+	writeJson(w, http.StatusOK, model.VersionHistoryResponse{
+		Events: page(events, offset, limit),
+		Offset: offset,
+		Limit:  limit,
+		Total:  len(events),
+	})
+}
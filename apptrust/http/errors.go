@@ -0,0 +1,164 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+// Process exit codes of failed commands. Requests that the AppTrust API rejects exit with a code
+// that identifies the reason, so that scripts can, for example, treat a version that already exists
+// differently from an authentication failure. All other errors exit with coreutils.ExitCodeError (1).
+var (
+	ExitCodeInvalidRequest   = coreutils.ExitCode{Code: 10}
+	ExitCodeUnauthenticated  = coreutils.ExitCode{Code: 11}
+	ExitCodePermissionDenied = coreutils.ExitCode{Code: 12}
+	ExitCodeNotFound         = coreutils.ExitCode{Code: 13}
+	ExitCodeConflict         = coreutils.ExitCode{Code: 14}
+	ExitCodeRateLimited      = coreutils.ExitCode{Code: 15}
+	ExitCodeServerError      = coreutils.ExitCode{Code: 16}
+)
+
+var requestIdHeaders = []string{"X-Request-Id", "X-JFrog-Request-Id"}
+
+// ApptrustError is an error response of the AppTrust API.
+// Use errors.As to inspect the status code and the error details returned by the server.
+type ApptrustError struct {
+	// Operation describes the failed operation, e.g., "failed to get application".
+	Operation  string
+	StatusCode int
+	Code       string
+	Message    string
+	Details    []string
+	RequestId  string
+	// Body is the raw response body.
+	Body []byte
+}
+
+type errorResponse struct {
+	Code      json.RawMessage `json:"code"`
+	Message   string          `json:"message"`
+	Details   json.RawMessage `json:"details"`
+	RequestId string          `json:"request_id"`
+	Errors    []struct {
+		Code    json.RawMessage `json:"code"`
+		Message string          `json:"message"`
+	} `json:"errors"`
+}
+
+// NewApptrustError creates an ApptrustError from a response with an unexpected status code.
+// Bodies in the AppTrust error format ({"code", "message", "details", "request_id"}) and in the
+// JFrog Platform format ({"errors": [{"code", "message"}]}) are parsed. Other bodies are kept as is.
+func NewApptrustError(operation string, response *http.Response, body []byte) *ApptrustError {
+	apptrustError := &ApptrustError{Operation: operation, Body: body}
+	if response != nil {
+		apptrustError.StatusCode = response.StatusCode
+		for _, header := range requestIdHeaders {
+			if requestId := response.Header.Get(header); requestId != "" {
+				apptrustError.RequestId = requestId
+				break
+			}
+		}
+	}
+
+	var parsed errorResponse
+	if len(bytes.TrimSpace(body)) == 0 || json.Unmarshal(body, &parsed) != nil {
+		return apptrustError
+	}
+	apptrustError.Code = rawToString(parsed.Code)
+	apptrustError.Message = parsed.Message
+	apptrustError.Details = parseDetails(parsed.Details)
+	if parsed.RequestId != "" {
+		apptrustError.RequestId = parsed.RequestId
+	}
+	if apptrustError.Message == "" && len(parsed.Errors) > 0 {
+		apptrustError.Code = rawToString(parsed.Errors[0].Code)
+		apptrustError.Message = parsed.Errors[0].Message
+		for _, detail := range parsed.Errors[1:] {
+			apptrustError.Details = append(apptrustError.Details, detail.Message)
+		}
+	}
+	return apptrustError
+}
+
+func (e *ApptrustError) Error() string {
+	message := fmt.Sprintf("%s. Status code: %d.", e.Operation, e.StatusCode)
+	if e.Message == "" {
+		if len(e.Body) > 0 {
+			message += "\n" + string(e.Body)
+		}
+		return message
+	}
+
+	message += "\n" + e.Message
+	if e.Code != "" {
+		message += fmt.Sprintf(" (code: %s)", e.Code)
+	}
+	if len(e.Details) > 0 {
+		message += "\nDetails: " + strings.Join(e.Details, "; ")
+	}
+	if e.RequestId != "" {
+		message += "\nRequest ID: " + e.RequestId
+	}
+	return message
+}
+
+// ExitCode returns the process exit code for the error, according to its status code.
+func (e *ApptrustError) ExitCode() coreutils.ExitCode {
+	switch {
+	case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity:
+		return ExitCodeInvalidRequest
+	case e.StatusCode == http.StatusUnauthorized:
+		return ExitCodeUnauthenticated
+	case e.StatusCode == http.StatusForbidden:
+		return ExitCodePermissionDenied
+	case e.StatusCode == http.StatusNotFound:
+		return ExitCodeNotFound
+	case e.StatusCode == http.StatusConflict:
+		return ExitCodeConflict
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ExitCodeRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ExitCodeServerError
+	default:
+		return coreutils.ExitCodeError
+	}
+}
+
+// As lets errors.As convert the error to a coreutils.CliError, which sets the process exit code.
+func (e *ApptrustError) As(target interface{}) bool {
+	cliError, ok := target.(*coreutils.CliError)
+	if !ok {
+		return false
+	}
+	*cliError = coreutils.CliError{ExitCode: e.ExitCode(), ErrorMsg: e.Error()}
+	return true
+}
+
+// rawToString returns a JSON string value without quotes, and any other JSON value as is.
+func rawToString(raw json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value
+	}
+	if string(raw) == "null" {
+		return ""
+	}
+	return string(raw)
+}
+
+// parseDetails accepts the error details as a list of strings, a single string, or any other JSON value.
+func parseDetails(raw json.RawMessage) []string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var details []string
+	if err := json.Unmarshal(raw, &details); err == nil {
+		return details
+	}
+	return []string{rawToString(raw)}
+}
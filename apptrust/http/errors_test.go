@@ -0,0 +1,118 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewApptrustError(t *testing.T) {
+	tests := []struct {
+		name            string
+		response        *http.Response
+		body            string
+		expectedError   *ApptrustError
+		expectedMessage string
+	}{
+		{
+			name:     "apptrust error format",
+			response: &http.Response{StatusCode: http.StatusConflict},
+			body:     `{"code":"VERSION_EXISTS","message":"Version 1.0.0 already exists","details":["tag: rc"],"request_id":"req-1"}`,
+			expectedError: &ApptrustError{
+				StatusCode: http.StatusConflict,
+				Code:       "VERSION_EXISTS",
+				Message:    "Version 1.0.0 already exists",
+				Details:    []string{"tag: rc"},
+				RequestId:  "req-1",
+			},
+			expectedMessage: "failed to create app version. Status code: 409.\nVersion 1.0.0 already exists (code: VERSION_EXISTS)\nDetails: tag: rc\nRequest ID: req-1",
+		},
+		{
+			name:     "platform error format",
+			response: &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{"X-Request-Id": []string{"req-2"}}},
+			body:     `{"errors":[{"code":403,"message":"Permission denied"},{"message":"Missing role"}]}`,
+			expectedError: &ApptrustError{
+				StatusCode: http.StatusForbidden,
+				Code:       "403",
+				Message:    "Permission denied",
+				Details:    []string{"Missing role"},
+				RequestId:  "req-2",
+			},
+			expectedMessage: "failed to create app version. Status code: 403.\nPermission denied (code: 403)\nDetails: Missing role\nRequest ID: req-2",
+		},
+		{
+			name:            "details as a string",
+			response:        &http.Response{StatusCode: http.StatusBadRequest},
+			body:            `{"message":"Invalid request","details":"version is required"}`,
+			expectedError:   &ApptrustError{StatusCode: http.StatusBadRequest, Message: "Invalid request", Details: []string{"version is required"}},
+			expectedMessage: "failed to create app version. Status code: 400.\nInvalid request\nDetails: version is required",
+		},
+		{
+			name:            "body that is not json",
+			response:        &http.Response{StatusCode: http.StatusBadGateway},
+			body:            "Bad Gateway",
+			expectedError:   &ApptrustError{StatusCode: http.StatusBadGateway},
+			expectedMessage: "failed to create app version. Status code: 502.\nBad Gateway",
+		},
+		{
+			name:            "empty body",
+			response:        &http.Response{StatusCode: http.StatusNotFound},
+			expectedError:   &ApptrustError{StatusCode: http.StatusNotFound},
+			expectedMessage: "failed to create app version. Status code: 404.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apptrustError := NewApptrustError("failed to create app version", tt.response, []byte(tt.body))
+
+			tt.expectedError.Operation = "failed to create app version"
+			tt.expectedError.Body = []byte(tt.body)
+			assert.Equal(t, tt.expectedError, apptrustError)
+			assert.Equal(t, tt.expectedMessage, apptrustError.Error())
+		})
+	}
+}
+
+func TestApptrustError_ExitCode(t *testing.T) {
+	tests := []struct {
+		statusCode       int
+		expectedExitCode coreutils.ExitCode
+	}{
+		{http.StatusBadRequest, ExitCodeInvalidRequest},
+		{http.StatusUnprocessableEntity, ExitCodeInvalidRequest},
+		{http.StatusUnauthorized, ExitCodeUnauthenticated},
+		{http.StatusForbidden, ExitCodePermissionDenied},
+		{http.StatusNotFound, ExitCodeNotFound},
+		{http.StatusConflict, ExitCodeConflict},
+		{http.StatusTooManyRequests, ExitCodeRateLimited},
+		{http.StatusInternalServerError, ExitCodeServerError},
+		{http.StatusServiceUnavailable, ExitCodeServerError},
+		{http.StatusMethodNotAllowed, coreutils.ExitCodeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			apptrustError := NewApptrustError("failed", &http.Response{StatusCode: tt.statusCode}, nil)
+			assert.Equal(t, tt.expectedExitCode, apptrustError.ExitCode())
+		})
+	}
+}
+
+func TestApptrustError_ErrorsAs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", NewApptrustError("failed to get application", &http.Response{StatusCode: http.StatusNotFound}, nil))
+
+	var apptrustError *ApptrustError
+	require.True(t, errors.As(err, &apptrustError))
+	assert.Equal(t, http.StatusNotFound, apptrustError.StatusCode)
+
+	var cliError coreutils.CliError
+	require.True(t, errors.As(err, &cliError))
+	assert.Equal(t, ExitCodeNotFound, cliError.ExitCode)
+	assert.Equal(t, "failed to get application. Status code: 404.", cliError.ErrorMsg)
+}
@@ -3,9 +3,11 @@ package http
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/jfrog/jfrog-client-go/utils/log"
 
@@ -23,6 +25,9 @@ const apptrustApiPath = "apptrust/api"
 
 type ApptrustHttpClient interface {
 	GetHttpClient() *jfroghttpclient.JfrogHttpClient
+	GetContext() context.Context
+	// GetLogger returns the logger of the client's diagnostic messages.
+	GetLogger() Logger
 	Post(path string, requestBody interface{}, params map[string]string) (resp *http.Response, body []byte, err error)
 	Get(path string, params map[string]string) (resp *http.Response, body []byte, err error)
 	Patch(path string, requestBody interface{}, params map[string]string) (resp *http.Response, body []byte, err error)
@@ -34,9 +39,96 @@ type apptrustHttpClient struct {
 	serverDetails *commonCliConfig.ServerDetails
 	authDetails   auth.ServiceDetails
 	serviceConfig clientConfig.Config
+	ctx           context.Context
+	retries       int
+	retryWait     time.Duration
+	tracer        *Tracer
+	logger        Logger
+	httpClient    *http.Client
+	tokenSource   TokenSource
+	plan          bool
 }
 
-func NewAppHttpClient(serverDetails *commonCliConfig.ServerDetails) (ApptrustHttpClient, error) {
+// Logger receives the diagnostic messages of the client: the requests it sends, and the retries of failed requests.
+// The loggers of jfrog-client-go implement it.
+type Logger interface {
+	Debug(a ...interface{})
+	Warn(a ...interface{})
+}
+
+// TokenSource provides the access tokens of the requests, e.g., short-lived tokens exchanged for an OIDC ID token.
+type TokenSource interface {
+	// Token returns an access token for the server. refresh is true when the server rejected the previous token,
+	// in which case a new token must be obtained rather than a cached one.
+	Token(ctx context.Context, serverDetails *commonCliConfig.ServerDetails, refresh bool) (string, error)
+}
+
+// ClientOption configures an ApptrustHttpClient created by NewAppHttpClient.
+type ClientOption func(*apptrustHttpClient)
+
+// WithContext sets the context of all requests. Canceling the context aborts the request in flight and any pending retry.
+func WithContext(ctx context.Context) ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.ctx = ctx
+	}
+}
+
+// WithTracer records every request and response with tracer. The caller is responsible for closing the tracer.
+func WithTracer(tracer *Tracer) ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.tracer = tracer
+	}
+}
+
+// WithLogger sets the logger of the client's diagnostic messages. By default, the jfrog-client-go logger is used.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.logger = logger
+	}
+}
+
+// WithHttpClient sends the requests with httpClient, e.g., to configure TLS. The client certificates and the insecure
+// TLS setting of the server details are ignored, since they configure the transport that httpClient replaces.
+func WithHttpClient(httpClient *http.Client) ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTokenSource authenticates the requests with the access tokens of source, instead of the credentials of the
+// server details. When the server rejects a token with 401 Unauthorized, a new token is obtained and the request is sent again.
+func WithTokenSource(source TokenSource) ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.tokenSource = source
+	}
+}
+
+// WithRetries sets the number of times a failed request is retried. Zero disables retries.
+func WithRetries(retries int) ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.retries = retries
+	}
+}
+
+// WithRetryWait sets the wait before the first retry. The wait doubles after each retry.
+func WithRetryWait(retryWait time.Duration) ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.retryWait = retryWait
+	}
+}
+
+func NewAppHttpClient(serverDetails *commonCliConfig.ServerDetails, options ...ClientOption) (ApptrustHttpClient, error) {
+	appClient := &apptrustHttpClient{
+		serverDetails: serverDetails,
+		ctx:           context.Background(),
+		retries:       DefaultRetries,
+		retryWait:     DefaultRetryWait,
+		logger:        log.GetLogger(),
+	}
+	for _, option := range options {
+		option(appClient)
+	}
+
 	certsPath, err := coreutils.GetJfrogCertsDir()
 	if err != nil {
 		return nil, err
@@ -51,7 +143,9 @@ func NewAppHttpClient(serverDetails *commonCliConfig.ServerDetails) (ApptrustHtt
 		SetServiceDetails(authDetails).
 		SetCertificatesPath(certsPath).
 		SetInsecureTls(serverDetails.InsecureTls).
-		SetHttpRetries(1).
+		SetContext(appClient.ctx).
+		// Retries are handled by apptrustHttpClient, which knows which requests are safe to repeat.
+		SetHttpRetries(0).
 		Build()
 	if err != nil {
 		return nil, err
@@ -68,17 +162,15 @@ func NewAppHttpClient(serverDetails *commonCliConfig.ServerDetails) (ApptrustHtt
 		SetOverallRequestTimeout(serviceConfig.GetOverallRequestTimeout()).
 		SetRetries(serviceConfig.GetHttpRetries()).
 		SetRetryWaitMilliSecs(serviceConfig.GetHttpRetryWaitMilliSecs()).
+		SetHttpClient(appClient.httpClient).
 		Build()
 	if err != nil {
 		return nil, err
 	}
 
-	appClient := &apptrustHttpClient{
-		client:        jfHttpClient,
-		serverDetails: serverDetails,
-		authDetails:   authDetails,
-		serviceConfig: serviceConfig,
-	}
+	appClient.client = jfHttpClient
+	appClient.authDetails = authDetails
+	appClient.serviceConfig = serviceConfig
 	return appClient, nil
 }
 
@@ -86,6 +178,14 @@ func (c *apptrustHttpClient) GetHttpClient() *jfroghttpclient.JfrogHttpClient {
 	return c.client
 }
 
+func (c *apptrustHttpClient) GetContext() context.Context {
+	return c.ctx
+}
+
+func (c *apptrustHttpClient) GetLogger() Logger {
+	return c.logger
+}
+
 func (c *apptrustHttpClient) Post(path string, requestBody interface{}, params map[string]string) (resp *http.Response, body []byte, err error) {
 	url, err := utils.BuildUrl(c.serverDetails.Url, apptrustApiPath+path, params)
 	if err != nil {
@@ -97,8 +197,8 @@ func (c *apptrustHttpClient) Post(path string, requestBody interface{}, params m
 		return nil, nil, err
 	}
 
-	log.Debug("Sending POST request to:", url)
-	return c.client.SendPost(url, requestContent, c.getJsonHttpClientDetails())
+	c.logger.Debug("Sending POST request to:", url)
+	return c.send(http.MethodPost, url, requestContent)
 }
 
 func (c *apptrustHttpClient) Get(path string, params map[string]string) (resp *http.Response, body []byte, err error) {
@@ -107,9 +207,8 @@ func (c *apptrustHttpClient) Get(path string, params map[string]string) (resp *h
 		return nil, nil, err
 	}
 
-	log.Debug("Sending GET request to:", url)
-	response, body, _, err := c.client.SendGet(url, false, c.getJsonHttpClientDetails())
-	return response, body, err
+	c.logger.Debug("Sending GET request to:", url)
+	return c.send(http.MethodGet, url, nil)
 }
 
 func (c *apptrustHttpClient) Patch(path string, requestBody interface{}, params map[string]string) (resp *http.Response, body []byte, err error) {
@@ -123,8 +222,8 @@ func (c *apptrustHttpClient) Patch(path string, requestBody interface{}, params
 		return nil, nil, err
 	}
 
-	log.Debug("Sending PATCH request to:", url)
-	return c.client.SendPatch(url, requestContent, c.getJsonHttpClientDetails())
+	c.logger.Debug("Sending PATCH request to:", url)
+	return c.send(http.MethodPatch, url, requestContent)
 }
 
 func (c *apptrustHttpClient) toJsonBytes(payload interface{}) ([]byte, error) {
@@ -145,8 +244,8 @@ func (c *apptrustHttpClient) Delete(path string, params map[string]string) (resp
 		return nil, nil, err
 	}
 
-	log.Debug("Sending DELETE request to:", url)
-	return c.client.SendDelete(url, nil, c.getJsonHttpClientDetails())
+	c.logger.Debug("Sending DELETE request to:", url)
+	return c.send(http.MethodDelete, url, nil)
 }
 
 func (c *apptrustHttpClient) getJsonHttpClientDetails() *httputils.HttpClientDetails {
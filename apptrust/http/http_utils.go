@@ -1,5 +1,26 @@
 package http
 
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
 func IsSuccessStatusCode(statusCode int) bool {
 	return statusCode >= 200 && statusCode < 300
 }
+
+// DecodeResponse decodes the JSON body of a successful response of the operation, e.g., "create app version".
+// Responses without a body, such as those of some asynchronous operations, return defaultValue, which holds
+// what the request already tells about the result.
+func DecodeResponse[T any](operation string, body []byte, defaultValue *T) (*T, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return defaultValue, nil
+	}
+	decoded := new(T)
+	if err := json.Unmarshal(body, decoded); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the %s response: %s", operation, err.Error())
+	}
+	return decoded, nil
+}
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeResponse(t *testing.T) {
+	type item struct {
+		Name string `json:"name"`
+	}
+	defaultValue := &item{Name: "default"}
+
+	decoded, err := DecodeResponse("get item", []byte(`{"name":"item"}`), defaultValue)
+	assert.NoError(t, err)
+	assert.Equal(t, &item{Name: "item"}, decoded)
+
+	decoded, err = DecodeResponse("get item", []byte(" \n"), defaultValue)
+	assert.NoError(t, err)
+	assert.Same(t, defaultValue, decoded)
+
+	decoded, err = DecodeResponse("get item", []byte("not-json"), defaultValue)
+	assert.ErrorContains(t, err, "failed to parse the get item response")
+	assert.Nil(t, decoded)
+}
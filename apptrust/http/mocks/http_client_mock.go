@@ -10,9 +10,12 @@
 package mock_http
 
 import (
-	http "net/http"
+	context "context"
+	http0 "net/http"
 	reflect "reflect"
 
+	http "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	config "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	jfroghttpclient "github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,10 +45,10 @@ func (m *MockApptrustHttpClient) EXPECT() *MockApptrustHttpClientMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockApptrustHttpClient) Delete(path string, params map[string]string) (*http.Response, []byte, error) {
+func (m *MockApptrustHttpClient) Delete(path string, params map[string]string) (*http0.Response, []byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", path, params)
-	ret0, _ := ret[0].(*http.Response)
+	ret0, _ := ret[0].(*http0.Response)
 	ret1, _ := ret[1].([]byte)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -58,10 +61,10 @@ func (mr *MockApptrustHttpClientMockRecorder) Delete(path, params any) *gomock.C
 }
 
 // Get mocks base method.
-func (m *MockApptrustHttpClient) Get(path string, params map[string]string) (*http.Response, []byte, error) {
+func (m *MockApptrustHttpClient) Get(path string, params map[string]string) (*http0.Response, []byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", path, params)
-	ret0, _ := ret[0].(*http.Response)
+	ret0, _ := ret[0].(*http0.Response)
 	ret1, _ := ret[1].([]byte)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -73,6 +76,20 @@ func (mr *MockApptrustHttpClientMockRecorder) Get(path, params any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockApptrustHttpClient)(nil).Get), path, params)
 }
 
+// GetContext mocks base method.
+func (m *MockApptrustHttpClient) GetContext() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContext")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// GetContext indicates an expected call of GetContext.
+func (mr *MockApptrustHttpClientMockRecorder) GetContext() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContext", reflect.TypeOf((*MockApptrustHttpClient)(nil).GetContext))
+}
+
 // GetHttpClient mocks base method.
 func (m *MockApptrustHttpClient) GetHttpClient() *jfroghttpclient.JfrogHttpClient {
 	m.ctrl.T.Helper()
@@ -87,11 +104,25 @@ func (mr *MockApptrustHttpClientMockRecorder) GetHttpClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpClient", reflect.TypeOf((*MockApptrustHttpClient)(nil).GetHttpClient))
 }
 
+// GetLogger mocks base method.
+func (m *MockApptrustHttpClient) GetLogger() http.Logger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogger")
+	ret0, _ := ret[0].(http.Logger)
+	return ret0
+}
+
+// GetLogger indicates an expected call of GetLogger.
+func (mr *MockApptrustHttpClientMockRecorder) GetLogger() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogger", reflect.TypeOf((*MockApptrustHttpClient)(nil).GetLogger))
+}
+
 // Patch mocks base method.
-func (m *MockApptrustHttpClient) Patch(path string, requestBody any, params map[string]string) (*http.Response, []byte, error) {
+func (m *MockApptrustHttpClient) Patch(path string, requestBody any, params map[string]string) (*http0.Response, []byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Patch", path, requestBody, params)
-	ret0, _ := ret[0].(*http.Response)
+	ret0, _ := ret[0].(*http0.Response)
 	ret1, _ := ret[1].([]byte)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -104,10 +135,10 @@ func (mr *MockApptrustHttpClientMockRecorder) Patch(path, requestBody, params an
 }
 
 // Post mocks base method.
-func (m *MockApptrustHttpClient) Post(path string, requestBody any, params map[string]string) (*http.Response, []byte, error) {
+func (m *MockApptrustHttpClient) Post(path string, requestBody any, params map[string]string) (*http0.Response, []byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Post", path, requestBody, params)
-	ret0, _ := ret[0].(*http.Response)
+	ret0, _ := ret[0].(*http0.Response)
 	ret1, _ := ret[1].([]byte)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -118,3 +149,98 @@ func (mr *MockApptrustHttpClientMockRecorder) Post(path, requestBody, params any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockApptrustHttpClient)(nil).Post), path, requestBody, params)
 }
+
+// MockLogger is a mock of Logger interface.
+type MockLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoggerMockRecorder
+	isgomock struct{}
+}
+
+// MockLoggerMockRecorder is the mock recorder for MockLogger.
+type MockLoggerMockRecorder struct {
+	mock *MockLogger
+}
+
+// NewMockLogger creates a new mock instance.
+func NewMockLogger(ctrl *gomock.Controller) *MockLogger {
+	mock := &MockLogger{ctrl: ctrl}
+	mock.recorder = &MockLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogger) EXPECT() *MockLoggerMockRecorder {
+	return m.recorder
+}
+
+// Debug mocks base method.
+func (m *MockLogger) Debug(a ...any) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a_2 := range a {
+		varargs = append(varargs, a_2)
+	}
+	m.ctrl.Call(m, "Debug", varargs...)
+}
+
+// Debug indicates an expected call of Debug.
+func (mr *MockLoggerMockRecorder) Debug(a ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Debug", reflect.TypeOf((*MockLogger)(nil).Debug), a...)
+}
+
+// Warn mocks base method.
+func (m *MockLogger) Warn(a ...any) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a_2 := range a {
+		varargs = append(varargs, a_2)
+	}
+	m.ctrl.Call(m, "Warn", varargs...)
+}
+
+// Warn indicates an expected call of Warn.
+func (mr *MockLoggerMockRecorder) Warn(a ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warn", reflect.TypeOf((*MockLogger)(nil).Warn), a...)
+}
+
+// MockTokenSource is a mock of TokenSource interface.
+type MockTokenSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenSourceMockRecorder is the mock recorder for MockTokenSource.
+type MockTokenSourceMockRecorder struct {
+	mock *MockTokenSource
+}
+
+// NewMockTokenSource creates a new mock instance.
+func NewMockTokenSource(ctrl *gomock.Controller) *MockTokenSource {
+	mock := &MockTokenSource{ctrl: ctrl}
+	mock.recorder = &MockTokenSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenSource) EXPECT() *MockTokenSourceMockRecorder {
+	return m.recorder
+}
+
+// Token mocks base method.
+func (m *MockTokenSource) Token(ctx context.Context, serverDetails *config.ServerDetails, refresh bool) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token", ctx, serverDetails, refresh)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockTokenSourceMockRecorder) Token(ctx, serverDetails, refresh any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockTokenSource)(nil).Token), ctx, serverDetails, refresh)
+}
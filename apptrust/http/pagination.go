@@ -0,0 +1,171 @@
+package http
+
+import (
+	"iter"
+	"maps"
+	"net/http"
+	"strconv"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	// DefaultPageSize is the number of items requested per page when PageOptions.PageSize is not set.
+	DefaultPageSize = 100
+	// MaxPageSize is the largest number of items that the AppTrust API returns in a single page.
+	MaxPageSize = 1000
+
+	offsetParam = "offset"
+	limitParam  = "limit"
+	cursorParam = "cursor"
+)
+
+// Page is a single page of a list response.
+type Page[T any] struct {
+	Items []T
+	// Total is the number of items across all pages, or zero if the response does not report it.
+	Total int
+	// NextCursor identifies the next page in cursor-based listings. It is empty on the last page,
+	// and in offset-based listings.
+	NextCursor string
+}
+
+// PageDecoder decodes a response body into a page.
+type PageDecoder[T any] func(body []byte) (*Page[T], error)
+
+// PageOptions configures Paginate.
+type PageOptions struct {
+	// Params are query parameters sent with every page request, e.g., filters.
+	Params map[string]string
+	// PageSize is the number of items requested per page. Values above MaxPageSize are capped.
+	PageSize int
+	// MaxItems caps the number of items returned. Zero returns all items.
+	MaxItems int
+	// Operation describes the listing in errors, e.g., "failed to list applications".
+	Operation string
+}
+
+// Paginate returns an iterator over the items of a list endpoint, requesting pages as the items are consumed.
+// The first page is requested by offset and limit. If a response contains a next cursor, the following page is
+// requested by that cursor. Otherwise, the offset advances by the number of items received, until the total count
+// is reached or, when the total is not reported, until a page has fewer items than requested.
+// Iteration stops at the first error, which is yielded with the zero value of T, or when the loop body breaks,
+// in which case no further pages are requested.
+func Paginate[T any](client ApptrustHttpClient, path string, options PageOptions, decode PageDecoder[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		pageSize := options.PageSize
+		if pageSize <= 0 {
+			pageSize = DefaultPageSize
+		}
+		pageSize = min(pageSize, MaxPageSize)
+
+		offset, returned := 0, 0
+		cursor := ""
+		for {
+			limit := pageSize
+			if options.MaxItems > 0 {
+				limit = min(limit, options.MaxItems-returned)
+			}
+			params := maps.Clone(options.Params)
+			if params == nil {
+				params = map[string]string{}
+			}
+			params[limitParam] = strconv.Itoa(limit)
+			if cursor != "" {
+				params[cursorParam] = cursor
+			} else {
+				params[offsetParam] = strconv.Itoa(offset)
+			}
+
+			response, responseBody, err := client.Get(path, params)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if response.StatusCode != http.StatusOK {
+				yield(zero, NewApptrustError(options.Operation, response, responseBody))
+				return
+			}
+			page, err := decode(responseBody)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+				returned++
+				if options.MaxItems > 0 && returned >= options.MaxItems {
+					return
+				}
+			}
+
+			offset += len(page.Items)
+			switch {
+			case page.NextCursor != "":
+				if page.NextCursor == cursor {
+					yield(zero, errorutils.CheckErrorf("%s: the server returned the same page cursor twice", options.Operation))
+					return
+				}
+				cursor = page.NextCursor
+			case cursor != "", len(page.Items) == 0:
+				return
+			case page.Total > 0:
+				if offset >= page.Total {
+					return
+				}
+			case len(page.Items) < limit:
+				return
+			}
+		}
+	}
+}
+
+// Limit returns an iterator over the first maxItems items of an iterator, or over all its items if maxItems is zero.
+// Since items are consumed lazily, no further pages are requested once the cap is reached.
+func Limit[T any](items iter.Seq2[T, error], maxItems int) iter.Seq2[T, error] {
+	if maxItems <= 0 {
+		return items
+	}
+	return func(yield func(T, error) bool) {
+		returned := 0
+		for item, err := range items {
+			if !yield(item, err) || err != nil {
+				return
+			}
+			if returned++; returned >= maxItems {
+				return
+			}
+		}
+	}
+}
+
+// Sequence returns an iterator over the items, followed by err if it is not nil.
+func Sequence[T any](items []T, err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// Collect returns all the items of an iterator, or the first error.
+func Collect[T any](items iter.Seq2[T, error]) ([]T, error) {
+	collected := []T{}
+	for item, err := range items {
+		if err != nil {
+			return nil, err
+		}
+		collected = append(collected, item)
+	}
+	return collected, nil
+}
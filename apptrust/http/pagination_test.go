@@ -0,0 +1,262 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPage struct {
+	Items      []int  `json:"items"`
+	Total      int    `json:"total,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+func decodeTestPage(body []byte) (*Page[int], error) {
+	var page testPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, err
+	}
+	return &Page[int]{Items: page.Items, Total: page.Total, NextCursor: page.NextCursor}, nil
+}
+
+// newPagingServer serves the items 0..count-1. Pages are capped at maxLimit items when it is set.
+// In cursor mode, the response contains a next cursor instead of a total count.
+func newPagingServer(t *testing.T, count, maxLimit int, reportTotal, cursorMode bool) (*httptest.Server, *[]url.Values) {
+	var mutex sync.Mutex
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		mutex.Lock()
+		requests = append(requests, query)
+		mutex.Unlock()
+
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		if maxLimit > 0 {
+			limit = min(limit, maxLimit)
+		}
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		if cursor := query.Get("cursor"); cursor != "" {
+			offset, _ = strconv.Atoi(cursor)
+		}
+
+		page := testPage{Items: []int{}}
+		for i := offset; i < min(offset+limit, count); i++ {
+			page.Items = append(page.Items, i)
+		}
+		if reportTotal {
+			page.Total = count
+		}
+		if cursorMode && offset+limit < count {
+			page.NextCursor = strconv.Itoa(offset + limit)
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name             string
+		count            int
+		maxLimit         int
+		reportTotal      bool
+		cursorMode       bool
+		options          PageOptions
+		expectedItems    int
+		expectedRequests int
+	}{
+		{
+			name:             "total count",
+			count:            250,
+			reportTotal:      true,
+			expectedItems:    250,
+			expectedRequests: 3,
+		},
+		{
+			name:             "total count with a server page size limit",
+			count:            250,
+			maxLimit:         50,
+			reportTotal:      true,
+			expectedItems:    250,
+			expectedRequests: 5,
+		},
+		{
+			name:             "without a total count, stops at a partial page",
+			count:            250,
+			expectedItems:    250,
+			expectedRequests: 3,
+		},
+		{
+			name:             "without a total count, stops at an empty page",
+			count:            200,
+			expectedItems:    200,
+			expectedRequests: 3,
+		},
+		{
+			name:             "next cursor",
+			count:            250,
+			cursorMode:       true,
+			expectedItems:    250,
+			expectedRequests: 3,
+		},
+		{
+			name:             "custom page size",
+			count:            25,
+			reportTotal:      true,
+			options:          PageOptions{PageSize: 10},
+			expectedItems:    25,
+			expectedRequests: 3,
+		},
+		{
+			name:             "page size is capped",
+			count:            1500,
+			reportTotal:      true,
+			options:          PageOptions{PageSize: 5000},
+			expectedItems:    1500,
+			expectedRequests: 2,
+		},
+		{
+			name:             "max items",
+			count:            250,
+			reportTotal:      true,
+			options:          PageOptions{PageSize: 100, MaxItems: 120},
+			expectedItems:    120,
+			expectedRequests: 2,
+		},
+		{
+			name:             "empty listing",
+			reportTotal:      true,
+			expectedItems:    0,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newPagingServer(t, tt.count, tt.maxLimit, tt.reportTotal, tt.cursorMode)
+			client := newTestClient(t, server.URL)
+
+			items, err := Collect(Paginate(client, "/v1/items", tt.options, decodeTestPage))
+			require.NoError(t, err)
+			require.Len(t, items, tt.expectedItems)
+			for i, item := range items {
+				require.Equal(t, i, item)
+			}
+			assert.Len(t, *requests, tt.expectedRequests)
+		})
+	}
+}
+
+func TestPaginate_Params(t *testing.T) {
+	server, requests := newPagingServer(t, 25, 0, true, false)
+	client := newTestClient(t, server.URL)
+	params := map[string]string{"project_key": "proj"}
+
+	_, err := Collect(Paginate(client, "/v1/items", PageOptions{Params: params, PageSize: 20, MaxItems: 25}, decodeTestPage))
+	require.NoError(t, err)
+	require.Len(t, *requests, 2)
+	assert.Equal(t, url.Values{"project_key": {"proj"}, "offset": {"0"}, "limit": {"20"}}, (*requests)[0])
+	assert.Equal(t, url.Values{"project_key": {"proj"}, "offset": {"20"}, "limit": {"5"}}, (*requests)[1])
+	assert.Equal(t, map[string]string{"project_key": "proj"}, params)
+}
+
+func TestPaginate_EarlyTermination(t *testing.T) {
+	server, requests := newPagingServer(t, 250, 0, true, false)
+	client := newTestClient(t, server.URL)
+
+	var items []int
+	for item, err := range Paginate(client, "/v1/items", PageOptions{PageSize: 10}, decodeTestPage) {
+		require.NoError(t, err)
+		items = append(items, item)
+		if item == 14 {
+			break
+		}
+	}
+	assert.Len(t, items, 15)
+	assert.Len(t, *requests, 2)
+}
+
+func TestPaginate_Errors(t *testing.T) {
+	t.Run("error status", func(t *testing.T) {
+		server, _ := newTestServer(t, http.StatusForbidden)
+		client := newTestClient(t, server.URL)
+
+		items, err := Collect(Paginate(client, "/v1/items", PageOptions{Operation: "failed to list items"}, decodeTestPage))
+		assert.Nil(t, items)
+		var apptrustError *ApptrustError
+		require.ErrorAs(t, err, &apptrustError)
+		assert.Equal(t, http.StatusForbidden, apptrustError.StatusCode)
+		assert.Equal(t, "failed to list items", apptrustError.Operation)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		server, _ := newTestServer(t)
+		client := newTestClient(t, server.URL)
+		decodeErr := errors.New("bad page")
+
+		_, err := Collect(Paginate(client, "/v1/items", PageOptions{}, func([]byte) (*Page[int], error) { return nil, decodeErr }))
+		assert.ErrorIs(t, err, decodeErr)
+	})
+
+	t.Run("repeated cursor", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"items":[1],"next_cursor":"same"}`)
+		}))
+		t.Cleanup(server.Close)
+		client := newTestClient(t, server.URL)
+
+		_, err := Collect(Paginate(client, "/v1/items", PageOptions{Operation: "failed to list items"}, decodeTestPage))
+		assert.ErrorContains(t, err, "failed to list items: the server returned the same page cursor twice")
+	})
+}
+
+func TestLimit(t *testing.T) {
+	listErr := errors.New("list error")
+	tests := []struct {
+		name          string
+		items         []int
+		err           error
+		maxItems      int
+		expectedItems []int
+		expectedError error
+	}{
+		{name: "no limit", items: []int{1, 2, 3}, expectedItems: []int{1, 2, 3}},
+		{name: "limit below count", items: []int{1, 2, 3}, maxItems: 2, expectedItems: []int{1, 2}},
+		{name: "limit above count", items: []int{1, 2, 3}, maxItems: 5, expectedItems: []int{1, 2, 3}},
+		{name: "error before limit", items: []int{1}, err: listErr, maxItems: 2, expectedError: listErr},
+		{name: "error after limit", items: []int{1, 2}, err: listErr, maxItems: 2, expectedItems: []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := Collect(Limit(Sequence(tt.items, tt.err), tt.maxItems))
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedItems, items)
+		})
+	}
+}
+
+func TestLimit_StopsPaging(t *testing.T) {
+	server, requests := newPagingServer(t, 250, 0, true, false)
+	client := newTestClient(t, server.URL)
+
+	items, err := Collect(Limit(Paginate(client, "/v1/items", PageOptions{PageSize: 10}, decodeTestPage), 15))
+	require.NoError(t, err)
+	assert.Len(t, items, 15)
+	assert.Len(t, *requests, 2)
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// ErrPlanned is matched by the errors that the requests of a client created with WithPlan return.
+var ErrPlanned = errors.New("the request was planned and not sent")
+
+// PlannedRequest is a request that a client created with WithPlan didn't send.
+type PlannedRequest struct {
+	Method   string            `json:"method"`
+	Endpoint string            `json:"endpoint"`
+	Params   map[string]string `json:"params,omitempty"`
+	Payload  json.RawMessage   `json:"payload,omitempty"`
+}
+
+// PlannedError is returned instead of a response by a client created with WithPlan, for the requests that change
+// resources. It holds the request that
+// would have been sent. Use errors.As to get it, or errors.Is with ErrPlanned to tell it apart from other errors.
+type PlannedError struct {
+	Request PlannedRequest
+}
+
+func (e *PlannedError) Error() string {
+	return ErrPlanned.Error()
+}
+
+func (e *PlannedError) Is(target error) bool {
+	return target == ErrPlanned
+}
+
+// WithPlan makes the client return a PlannedError for each request other than GET, instead of sending it.
+// GET requests are sent, since they don't change resources. The credentials of the requests aren't included in
+// the planned requests, and the token source is called only for the GET requests.
+func WithPlan() ClientOption {
+	return func(c *apptrustHttpClient) {
+		c.plan = true
+	}
+}
+
+func newPlannedError(method, requestUrl string, content []byte) error {
+	parsedUrl, err := url.Parse(requestUrl)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	request := PlannedRequest{Method: method, Payload: content}
+	if query := parsedUrl.Query(); len(query) > 0 {
+		request.Params = make(map[string]string, len(query))
+		for name := range query {
+			request.Params[name] = query.Get(name)
+		}
+	}
+	parsedUrl.RawQuery = ""
+	request.Endpoint = parsedUrl.String()
+	return &PlannedError{Request: request}
+}
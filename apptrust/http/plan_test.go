@@ -0,0 +1,77 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	commonCliConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type panickingTokenSource struct{}
+
+func (panickingTokenSource) Token(context.Context, *commonCliConfig.ServerDetails, bool) (string, error) {
+	panic("the token source must not be called for planned requests")
+}
+
+func TestWithPlan(t *testing.T) {
+	server, requests := newTestServer(t)
+	client := newTestClient(t, server.URL, WithPlan(), WithTokenSource(panickingTokenSource{}))
+
+	tests := []struct {
+		name     string
+		send     func() (*http.Response, []byte, error)
+		expected PlannedRequest
+	}{
+		{
+			name: "post",
+			send: func() (*http.Response, []byte, error) {
+				return client.Post("/v1/applications/app/versions", map[string]string{"version": "1.0.0"}, map[string]string{"async": "false"})
+			},
+			expected: PlannedRequest{
+				Method:   http.MethodPost,
+				Endpoint: server.URL + "/apptrust/api/v1/applications/app/versions",
+				Params:   map[string]string{"async": "false"},
+				Payload:  []byte(`{"version":"1.0.0"}`),
+			},
+		},
+		{
+			name:     "delete",
+			send:     func() (*http.Response, []byte, error) { return client.Delete("/v1/applications/app", nil) },
+			expected: PlannedRequest{Method: http.MethodDelete, Endpoint: server.URL + "/apptrust/api/v1/applications/app"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body, err := tt.send()
+			assert.Nil(t, resp)
+			assert.Nil(t, body)
+			assert.ErrorIs(t, err, ErrPlanned)
+			var plannedErr *PlannedError
+			require.True(t, errors.As(err, &plannedErr))
+			assert.Equal(t, tt.expected, plannedErr.Request)
+		})
+	}
+	assert.Zero(t, requests.Load())
+}
+
+func TestWithPlan_SendsGetRequests(t *testing.T) {
+	server, requests := newTestServer(t)
+	tokenSource := &countingTokenSource{}
+	client := newTestClient(t, server.URL, WithPlan(), WithTokenSource(tokenSource))
+
+	resp, body, err := client.Get("/v1/applications", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"status":"ok"}`, string(body))
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Equal(t, 1, tokenSource.tokens)
+
+	_, _, err = client.Patch("/v1/applications/app", map[string]string{"description": "web"}, nil)
+	assert.ErrorIs(t, err, ErrPlanned)
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRetries   = 3
+	DefaultRetryWait = time.Second
+
+	// maxRetryWait caps the exponential backoff between retries.
+	maxRetryWait = 30 * time.Second
+	// maxRetryAfter caps the wait requested by the server in the Retry-After header.
+	maxRetryAfter = 5 * time.Minute
+)
+
+// send sends the request, retrying it with exponential backoff while it fails with a retryable error.
+// After the last attempt, the response is returned as is, so that callers report the server error.
+// If the token of the token source is rejected, the request is sent once more with a new token, without counting as a retry.
+// A client created with WithPlan returns the requests other than GET in a PlannedError instead of sending them.
+func (c *apptrustHttpClient) send(method, url string, content []byte) (resp *http.Response, body []byte, err error) {
+	if c.plan && method != http.MethodGet {
+		return nil, nil, newPlannedError(method, url, content)
+	}
+	// refreshToken is set only for the resend that follows a rejected token. The retries that follow it use the new
+	// token, which the token source caches.
+	refreshToken, refreshed := false, false
+	for attempt := 0; ; attempt++ {
+		resp, body, err = c.sendOnce(method, url, content, refreshToken)
+		refreshToken = false
+		if c.ctx.Err() != nil {
+			return resp, body, c.canceledError(method, url)
+		}
+		if !refreshed && c.tokenSource != nil && err == nil && resp.StatusCode == http.StatusUnauthorized {
+			c.logger.Debug("The access token was rejected. Sending the request again with a new token...")
+			refreshToken, refreshed = true, true
+			attempt--
+			continue
+		}
+		if attempt >= c.retries || !shouldRetry(method, resp, err) {
+			return resp, body, err
+		}
+
+		wait := retryDelay(attempt, c.retryWait, resp)
+		c.logger.Warn(fmt.Sprintf("%s request to %s failed: %s. Retrying in %s (attempt %d of %d)...",
+			method, url, describeFailure(resp, err), wait.Round(time.Millisecond), attempt+1, c.retries))
+		timer := time.NewTimer(wait)
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return resp, body, c.canceledError(method, url)
+		case <-timer.C:
+		}
+	}
+}
+
+// canceledError reports that the context was canceled, by a timeout or by the user, with the cancellation cause.
+// The cause wraps context.Canceled or context.DeadlineExceeded, for use with errors.Is.
+func (c *apptrustHttpClient) canceledError(method, url string) error {
+	return fmt.Errorf("%s request to %s was canceled: %w", method, url, context.Cause(c.ctx))
+}
+
+func (c *apptrustHttpClient) sendOnce(method, url string, content []byte, refreshToken bool) (*http.Response, []byte, error) {
+	httpClientDetails := c.getJsonHttpClientDetails()
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token(c.ctx, c.serverDetails, refreshToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		httpClientDetails.AccessToken = token
+	}
+	// Prevent the underlying client from retrying on its own.
+	httpClientDetails.PreRetryInterceptors = append(httpClientDetails.PreRetryInterceptors, func() bool { return false })
+	started := time.Now()
+	resp, body, _, err := c.client.Send(method, url, content, false, true, httpClientDetails, "")
+	if c.tracer != nil {
+		if traceErr := c.tracer.record(method, url, httpClientDetails, content, resp, body, err, started, time.Since(started)); traceErr != nil {
+			c.logger.Warn("Failed to write the HTTP trace:", traceErr.Error())
+		}
+	}
+	return resp, body, err
+}
+
+// shouldRetry reports whether a failed request can be sent again.
+// GET, DELETE and PATCH requests are retried on connection errors and on transient server errors.
+// POST requests create or change resources (e.g., create or promote a version), so they are retried only
+// when the server states that the request was not processed: 429 Too Many Requests and 503 Service Unavailable.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return method != http.MethodPost && isTransientError(err)
+	}
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusRequestTimeout, http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return method != http.MethodPost
+	default:
+		return false
+	}
+}
+
+// isTransientError reports whether err is a connection failure or timeout, rather than, for example, an invalid URL.
+func isTransientError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// retryDelay returns the wait before the next attempt.
+// The server's Retry-After header takes precedence. Otherwise, retryWait is doubled after each attempt,
+// and a random jitter of up to half the wait spreads the retries of concurrent clients.
+func retryDelay(attempt int, retryWait time.Duration, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(wait, maxRetryAfter)
+		}
+	}
+
+	wait := retryWait
+	for i := 0; i < attempt && wait < maxRetryWait; i++ {
+		wait *= 2
+	}
+	wait = min(wait, maxRetryWait)
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int64N(half+1))
+	}
+	return wait
+}
+
+// parseRetryAfter parses a Retry-After header value, given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func describeFailure(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return resp.Status
+}
@@ -0,0 +1,369 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	commonCliConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer starts a server that responds with the given status codes in turn, and then with 200 OK.
+func newTestServer(t *testing.T, statusCodes ...int) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := int(requests.Add(1))
+		if attempt <= len(statusCodes) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(statusCodes[attempt-1])
+			_, _ = w.Write([]byte(`{"errors":[{"message":"transient"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestClient(t *testing.T, url string, options ...ClientOption) ApptrustHttpClient {
+	options = append([]ClientOption{WithRetryWait(time.Millisecond)}, options...)
+	client, err := NewAppHttpClient(&commonCliConfig.ServerDetails{Url: url + "/", AccessToken: "token"}, options...)
+	require.NoError(t, err)
+	return client
+}
+
+func TestSend_Retries(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		statusCodes      []int
+		expectedStatus   int
+		expectedRequests int32
+	}{
+		{
+			name:             "get retries server errors",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusBadGateway, http.StatusInternalServerError},
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 3,
+		},
+		{
+			name:             "delete retries rate limiting",
+			method:           http.MethodDelete,
+			statusCodes:      []int{http.StatusTooManyRequests},
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+		},
+		{
+			name:             "patch retries unavailable service",
+			method:           http.MethodPatch,
+			statusCodes:      []int{http.StatusServiceUnavailable},
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+		},
+		{
+			name:             "post retries rate limiting",
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 3,
+		},
+		{
+			name:             "post does not retry server errors",
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusInternalServerError},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedRequests: 1,
+		},
+		{
+			name:             "post does not retry gateway timeouts",
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusGatewayTimeout},
+			expectedStatus:   http.StatusGatewayTimeout,
+			expectedRequests: 1,
+		},
+		{
+			name:             "client errors are not retried",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusNotFound},
+			expectedStatus:   http.StatusNotFound,
+			expectedRequests: 1,
+		},
+		{
+			name:             "last response is returned when retries are exhausted",
+			method:           http.MethodGet,
+			statusCodes:      []int{502, 502, 502, 502, 502},
+			expectedStatus:   http.StatusBadGateway,
+			expectedRequests: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newTestServer(t, tt.statusCodes...)
+			client := newTestClient(t, server.URL)
+
+			var resp *http.Response
+			var err error
+			switch tt.method {
+			case http.MethodGet:
+				resp, _, err = client.Get("/v1/applications", nil)
+			case http.MethodDelete:
+				resp, _, err = client.Delete("/v1/applications/app", nil)
+			case http.MethodPatch:
+				resp, _, err = client.Patch("/v1/applications/app", map[string]string{}, nil)
+			case http.MethodPost:
+				resp, _, err = client.Post("/v1/applications", map[string]string{}, nil)
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedRequests, requests.Load())
+		})
+	}
+}
+
+func TestSend_RetriesOption(t *testing.T) {
+	server, requests := newTestServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	client := newTestClient(t, server.URL, WithRetries(1))
+
+	resp, body, err := client.Get("/v1/applications", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Contains(t, string(body), "transient")
+	assert.Equal(t, int32(2), requests.Load())
+
+	requests.Store(0)
+	client = newTestClient(t, server.URL, WithRetries(0))
+	resp, _, err = client.Get("/v1/applications", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+// recordingLogger records the messages of the client.
+type recordingLogger struct {
+	debug, warn []string
+}
+
+func (l *recordingLogger) Debug(a ...interface{}) {
+	l.debug = append(l.debug, fmt.Sprint(a...))
+}
+
+func (l *recordingLogger) Warn(a ...interface{}) {
+	l.warn = append(l.warn, fmt.Sprint(a...))
+}
+
+func TestSend_Logger(t *testing.T) {
+	server, _ := newTestServer(t, http.StatusServiceUnavailable)
+	logger := &recordingLogger{}
+	client := newTestClient(t, server.URL, WithLogger(logger))
+
+	_, _, err := client.Get("/v1/applications", nil)
+	require.NoError(t, err)
+	require.Len(t, logger.debug, 1)
+	assert.Contains(t, logger.debug[0], "Sending GET request to:")
+	require.Len(t, logger.warn, 1)
+	assert.Contains(t, logger.warn[0], "503 Service Unavailable. Retrying in")
+}
+
+func TestSend_ConnectionErrors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	client := newTestClient(t, url, WithRetries(1))
+	_, _, err := client.Get("/v1/applications", nil)
+	assert.Error(t, err)
+	_, _, err = client.Post("/v1/applications", map[string]string{}, nil)
+	assert.Error(t, err)
+}
+
+func TestShouldRetry_ConnectionErrors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+	_, err := http.Get(url)
+	require.Error(t, err)
+
+	assert.True(t, shouldRetry(http.MethodGet, nil, err))
+	assert.True(t, shouldRetry(http.MethodDelete, nil, err))
+	assert.False(t, shouldRetry(http.MethodPost, nil, err))
+
+	_, err = http.Get("invalid://" + url)
+	require.Error(t, err)
+	assert.False(t, shouldRetry(http.MethodGet, nil, err))
+}
+
+func TestRetryDelay(t *testing.T) {
+	withRetryAfter := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+	}
+
+	t.Run("exponential backoff with jitter", func(t *testing.T) {
+		for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second} {
+			wait := retryDelay(attempt, time.Second, nil)
+			assert.GreaterOrEqual(t, wait, expected/2)
+			assert.LessOrEqual(t, wait, expected)
+		}
+	})
+
+	t.Run("backoff is capped", func(t *testing.T) {
+		wait := retryDelay(20, time.Second, nil)
+		assert.GreaterOrEqual(t, wait, maxRetryWait/2)
+		assert.LessOrEqual(t, wait, maxRetryWait)
+	})
+
+	t.Run("retry after in seconds", func(t *testing.T) {
+		assert.Equal(t, 7*time.Second, retryDelay(0, time.Second, withRetryAfter("7")))
+	})
+
+	t.Run("retry after is capped", func(t *testing.T) {
+		assert.Equal(t, maxRetryAfter, retryDelay(0, time.Second, withRetryAfter("86400")))
+	})
+
+	t.Run("invalid retry after is ignored", func(t *testing.T) {
+		wait := retryDelay(0, time.Second, withRetryAfter("soon"))
+		assert.LessOrEqual(t, wait, time.Second)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := parseRetryAfter("120", now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, wait)
+
+	wait, ok = parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, wait)
+
+	wait, ok = parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("-1", now)
+	assert.False(t, ok)
+}
+
+func TestSend_Canceled(t *testing.T) {
+	t.Run("pending retry", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			cancel()
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+		client := newTestClient(t, server.URL, WithContext(ctx), WithRetryWait(time.Minute))
+
+		_, _, err := client.Get("/v1/applications", nil)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorContains(t, err, "GET request to "+server.URL+"/apptrust/api/v1/applications was canceled")
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("request in flight", func(t *testing.T) {
+		unblock := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-unblock
+		}))
+		t.Cleanup(server.Close)
+		t.Cleanup(func() { close(unblock) })
+		ctx, cancel := context.WithTimeoutCause(context.Background(), 50*time.Millisecond,
+			fmt.Errorf("timed out: %w", context.DeadlineExceeded))
+		defer cancel()
+		client := newTestClient(t, server.URL, WithContext(ctx))
+
+		_, _, err := client.Post("/v1/applications", map[string]string{}, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, "was canceled: timed out")
+	})
+}
+
+// countingTokenSource returns "token-<n>", where n is the number of tokens obtained so far.
+type countingTokenSource struct {
+	tokens    int
+	refreshes int
+}
+
+func (s *countingTokenSource) Token(_ context.Context, _ *commonCliConfig.ServerDetails, refresh bool) (string, error) {
+	if refresh || s.tokens == 0 {
+		s.tokens++
+	}
+	if refresh {
+		s.refreshes++
+	}
+	return fmt.Sprintf("token-%d", s.tokens), nil
+}
+
+func TestSend_TokenSource(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	source := &countingTokenSource{}
+	client := newTestClient(t, server.URL, WithTokenSource(source), WithRetries(0))
+	resp, _, err := client.Post("/v1/applications", map[string]string{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, _, err = client.Get("/v1/applications", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2", "Bearer token-2"}, authorizations)
+
+	// A new token is obtained only once per request, so that a rejected token fails the request.
+	source.tokens = 2
+	authorizations = nil
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	resp, _, err = client.Get("/v1/applications", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer token-2", "Bearer token-3"}, authorizations)
+	assert.Equal(t, 2, source.refreshes)
+}
+
+func TestSend_TokenSourceRetries(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		switch len(authorizations) {
+		case 1:
+			w.WriteHeader(http.StatusUnauthorized)
+		case 2, 3:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	// The token is refreshed for the resend after the rejected token only, and the retries reuse the new token.
+	source := &countingTokenSource{}
+	client := newTestClient(t, server.URL, WithTokenSource(source), WithRetries(2), WithRetryWait(time.Millisecond))
+	resp, _, err := client.Get("/v1/applications", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2", "Bearer token-2", "Bearer token-2"}, authorizations)
+	assert.Equal(t, 1, source.refreshes)
+}
@@ -0,0 +1,289 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveNames are the parts of header, query parameter and JSON field names whose values are redacted in traces.
+var sensitiveNames = []string{"authorization", "cookie", "password", "passwd", "secret", "token", "api-key", "api_key", "apikey", "credential", "private"}
+
+// Tracer records the requests sent by ApptrustHttpClient and their responses, with secrets redacted.
+// Files with the .har extension are written in the HAR 1.2 format when the tracer is closed.
+// Other files are written as JSON Lines as requests complete, with a HAR entry per line.
+type Tracer struct {
+	mutex       sync.Mutex
+	writer      io.WriteCloser
+	har         bool
+	redactNames []string
+	entries     []harEntry
+}
+
+// NewFileTracer creates a Tracer that writes to the file at path.
+// Values of fields named like one of redactNames, in addition to the built-in sensitive names, are redacted.
+func NewFileTracer(path string, redactNames []string) (*Tracer, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to create the HTTP trace file: %s", err.Error())
+	}
+	return NewTracer(file, strings.EqualFold(filepath.Ext(path), ".har"), redactNames), nil
+}
+
+// NewTracer creates a Tracer that writes to writer, in the HAR format if har is true, and as JSON Lines otherwise.
+func NewTracer(writer io.WriteCloser, har bool, redactNames []string) *Tracer {
+	tracer := &Tracer{writer: writer, har: har, entries: []harEntry{}}
+	for _, name := range append(redactNames, sensitiveNames...) {
+		if name = strings.TrimSpace(name); name != "" {
+			tracer.redactNames = append(tracer.redactNames, strings.ToLower(name))
+		}
+	}
+	return tracer
+}
+
+// Close writes the HAR log, if needed, and closes the underlying writer.
+func (t *Tracer) Close() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var err error
+	if t.har {
+		err = json.NewEncoder(t.writer).Encode(harFile{Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "jfrog-cli-application", Version: "1.0"},
+			Entries: t.entries,
+		}})
+	}
+	if closeErr := t.writer.Close(); err == nil {
+		err = closeErr
+	}
+	return errorutils.CheckError(err)
+}
+
+func (t *Tracer) record(method, rawUrl string, httpClientDetails *httputils.HttpClientDetails, content []byte,
+	resp *http.Response, body []byte, sendErr error, started time.Time, duration time.Duration) error {
+	entry := harEntry{
+		StartedDateTime: started.Format(time.RFC3339Nano),
+		Time:            float64(duration.Microseconds()) / 1000,
+		Request:         t.harRequest(method, rawUrl, httpClientDetails, content),
+		Response:        t.harResponse(resp, body),
+		Timings:         harTimings{Send: 0, Wait: float64(duration.Microseconds()) / 1000, Receive: 0},
+	}
+	if sendErr != nil {
+		entry.Error = sendErr.Error()
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.har {
+		t.entries = append(t.entries, entry)
+		return nil
+	}
+	return errorutils.CheckError(json.NewEncoder(t.writer).Encode(entry))
+}
+
+func (t *Tracer) harRequest(method, rawUrl string, httpClientDetails *httputils.HttpClientDetails, content []byte) harRequest {
+	request := harRequest{
+		Method:      method,
+		Url:         rawUrl,
+		HttpVersion: "HTTP/1.1",
+		Headers:     []harNameValue{},
+		QueryString: []harNameValue{},
+		HeadersSize: -1,
+		BodySize:    len(content),
+	}
+	if parsedUrl, err := url.Parse(rawUrl); err == nil {
+		query := parsedUrl.Query()
+		for _, name := range sortedKeys(query) {
+			for _, value := range query[name] {
+				request.QueryString = append(request.QueryString, harNameValue{Name: name, Value: t.redact(name, value)})
+			}
+			if t.isSensitive(name) {
+				query[name] = []string{redactedValue}
+			}
+		}
+		parsedUrl.RawQuery = query.Encode()
+		request.Url = parsedUrl.String()
+	}
+
+	if httpClientDetails != nil {
+		if httpClientDetails.AccessToken != "" || httpClientDetails.ApiKey != "" || httpClientDetails.Password != "" {
+			request.Headers = append(request.Headers, harNameValue{Name: "Authorization", Value: redactedValue})
+		}
+		for _, name := range sortedKeys(httpClientDetails.Headers) {
+			request.Headers = append(request.Headers, harNameValue{Name: name, Value: t.redact(name, httpClientDetails.Headers[name])})
+		}
+	}
+	if content != nil {
+		request.PostData = &harPostData{MimeType: "application/json", Text: t.redactBody(content)}
+	}
+	return request
+}
+
+func (t *Tracer) harResponse(resp *http.Response, body []byte) harResponse {
+	response := harResponse{
+		HttpVersion: "HTTP/1.1",
+		Headers:     []harNameValue{},
+		HeadersSize: -1,
+		BodySize:    len(body),
+		Content:     harContent{Size: len(body), Text: t.redactBody(body)},
+	}
+	if resp == nil {
+		return response
+	}
+	response.Status = resp.StatusCode
+	response.StatusText = http.StatusText(resp.StatusCode)
+	response.Content.MimeType = resp.Header.Get("Content-Type")
+	for _, name := range sortedKeys(resp.Header) {
+		for _, value := range resp.Header[name] {
+			response.Headers = append(response.Headers, harNameValue{Name: name, Value: t.redact(name, value)})
+		}
+	}
+	return response
+}
+
+func (t *Tracer) isSensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, sensitiveName := range t.redactNames {
+		if strings.Contains(name, sensitiveName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Tracer) redact(name, value string) string {
+	if t.isSensitive(name) {
+		return redactedValue
+	}
+	return value
+}
+
+// redactBody redacts the values of sensitive fields in a JSON body. Bodies that are not JSON are kept as is.
+func (t *Tracer) redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+	redacted, err := json.Marshal(t.redactValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func (t *Tracer) redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range typed {
+			if t.isSensitive(key) {
+				typed[key] = redactedValue
+			} else {
+				typed[key] = t.redactValue(fieldValue)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = t.redactValue(item)
+		}
+	}
+	return value
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// The types below follow the HAR 1.2 specification: http://www.softwareishard.com/blog/har-12-spec/
+
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	// Error is a custom field, set when no response was received.
+	Error string `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	Url         string         `json:"url"`
+	HttpVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HttpVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectUrl string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTraceTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"application_key":"app","access_token":"server-secret"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func sendTracedRequests(t *testing.T, tracePath string, redactNames []string) {
+	server := newTraceTestServer(t)
+	tracer, err := NewFileTracer(tracePath, redactNames)
+	require.NoError(t, err)
+	client := newTestClient(t, server.URL, WithTracer(tracer))
+
+	request := map[string]interface{}{
+		"application_key": "app",
+		"properties":      map[string][]string{"db.password": {"hunter2"}, "env": {"prod"}, "license": {"abc"}},
+	}
+	_, _, err = client.Post("/v1/applications", request, map[string]string{"token": "query-secret", "async": "false"})
+	require.NoError(t, err)
+	_, _, err = client.Get("/v1/applications/app", nil)
+	require.NoError(t, err)
+	require.NoError(t, tracer.Close())
+}
+
+func TestTracer_Jsonl(t *testing.T) {
+	tracePath := filepath.Join(t.TempDir(), "trace.jsonl")
+	sendTracedRequests(t, tracePath, []string{"license"})
+
+	file, err := os.Open(tracePath)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, file.Close()) }()
+
+	var entries []harEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry harEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 2)
+
+	post := entries[0]
+	assert.Equal(t, http.MethodPost, post.Request.Method)
+	assert.Contains(t, post.Request.Url, "/apptrust/api/v1/applications?")
+	assert.NotContains(t, post.Request.Url, "query-secret")
+	assert.Contains(t, post.Request.QueryString, harNameValue{Name: "token", Value: redactedValue})
+	assert.Contains(t, post.Request.QueryString, harNameValue{Name: "async", Value: "false"})
+	assert.Contains(t, post.Request.Headers, harNameValue{Name: "Authorization", Value: redactedValue})
+	assert.Contains(t, post.Request.Headers, harNameValue{Name: "Content-Type", Value: "application/json"})
+	assert.JSONEq(t, `{"application_key":"app","properties":{"db.password":"[REDACTED]","env":["prod"],"license":"[REDACTED]"}}`, post.Request.PostData.Text)
+
+	assert.Equal(t, http.StatusCreated, post.Response.Status)
+	assert.Contains(t, post.Response.Headers, harNameValue{Name: "Set-Cookie", Value: redactedValue})
+	assert.JSONEq(t, `{"application_key":"app","access_token":"[REDACTED]"}`, post.Response.Content.Text)
+	assert.NotEmpty(t, post.StartedDateTime)
+
+	assert.Equal(t, http.MethodGet, entries[1].Request.Method)
+	assert.Nil(t, entries[1].Request.PostData)
+}
+
+func TestTracer_Har(t *testing.T) {
+	tracePath := filepath.Join(t.TempDir(), "trace.har")
+	sendTracedRequests(t, tracePath, nil)
+
+	content, err := os.ReadFile(tracePath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "hunter2")
+	assert.NotContains(t, string(content), "server-secret")
+
+	var har harFile
+	require.NoError(t, json.Unmarshal(content, &har))
+	assert.Equal(t, "1.2", har.Log.Version)
+	require.Len(t, har.Log.Entries, 2)
+	assert.Equal(t, http.MethodPost, har.Log.Entries[0].Request.Method)
+	assert.Equal(t, http.StatusCreated, har.Log.Entries[1].Response.Status)
+}
+
+func TestTracer_ConnectionError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	serverUrl := server.URL
+	server.Close()
+
+	tracePath := filepath.Join(t.TempDir(), "trace.har")
+	tracer, err := NewFileTracer(tracePath, nil)
+	require.NoError(t, err)
+	client := newTestClient(t, serverUrl, WithTracer(tracer), WithRetries(0))
+	_, _, err = client.Get("/v1/applications", nil)
+	assert.Error(t, err)
+	require.NoError(t, tracer.Close())
+
+	content, err := os.ReadFile(tracePath)
+	require.NoError(t, err)
+	var har harFile
+	require.NoError(t, json.Unmarshal(content, &har))
+	require.Len(t, har.Log.Entries, 1)
+	assert.Equal(t, 0, har.Log.Entries[0].Response.Status)
+	assert.NotEmpty(t, har.Log.Entries[0].Error)
+}
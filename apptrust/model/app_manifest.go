@@ -0,0 +1,15 @@
+package model
+
+// AppManifest is the desired state of an application, as declared in the manifests of the app-apply command.
+// Descriptor fields that are not set are left as they are on the server.
+type AppManifest struct {
+	AppDescriptor
+	// Packages are the package versions that are bound to the application.
+	Packages []ManifestPackage `json:"packages,omitempty"`
+}
+
+type ManifestPackage struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
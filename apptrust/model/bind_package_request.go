@@ -5,3 +5,10 @@ type BindPackageRequest struct {
 	Name    string `json:"package_name"`
 	Version string `json:"package_version"`
 }
+
+type BindPackageResponse struct {
+	ApplicationKey string `json:"application_key"`
+	Type           string `json:"package_type"`
+	Name           string `json:"package_name"`
+	Version        string `json:"package_version"`
+}
@@ -61,3 +61,17 @@ type CreateVersionReference struct {
 	ApplicationKey string `json:"application_key,omitempty"`
 	Version        string `json:"version"`
 }
+
+// AppVersionResponse is the application version returned when creating a version or updating its sources.
+type AppVersionResponse struct {
+	ApplicationKey string `json:"application_key"`
+	Version        string `json:"version"`
+	ProjectKey     string `json:"project_key,omitempty"`
+	Status         string `json:"status,omitempty"`
+	ReleaseStatus  string `json:"release_status,omitempty"`
+	CurrentStage   string `json:"current_stage,omitempty"`
+	Tag            string `json:"tag,omitempty"`
+	CreatedBy      string `json:"created_by,omitempty"`
+	Created        string `json:"created,omitempty"`
+	CreatedMillis  int64  `json:"created_millis,omitempty"`
+}
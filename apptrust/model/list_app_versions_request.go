@@ -0,0 +1,77 @@
+package model
+
+import "time"
+
+const (
+	ReleaseStatusPreRelease     = "pre_release"
+	ReleaseStatusReleased       = "released"
+	ReleaseStatusTrustedRelease = "trusted_release"
+)
+
+var ReleaseStatusValues = []string{
+	ReleaseStatusPreRelease,
+	ReleaseStatusReleased,
+	ReleaseStatusTrustedRelease,
+}
+
+const (
+	VersionStatusDraft      = "DRAFT"
+	VersionStatusInProgress = "IN_PROGRESS"
+	VersionStatusStarted    = "STARTED"
+	VersionStatusCompleted  = "COMPLETED"
+	VersionStatusFailed     = "FAILED"
+)
+
+const (
+	VersionSortByCreated = "created"
+	VersionSortBySemver  = "version"
+)
+
+var VersionSortByValues = []string{
+	VersionSortByCreated,
+	VersionSortBySemver,
+}
+
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+var SortOrderValues = []string{
+	SortOrderAsc,
+	SortOrderDesc,
+}
+
+// ListAppVersionsRequest holds the filters and sorting applied when listing the versions of an application.
+// Empty fields are ignored.
+type ListAppVersionsRequest struct {
+	Stage         string
+	Tag           string
+	ReleaseStatus string
+	// Draft, when set, returns only draft versions (true) or only non-draft versions (false).
+	Draft         *bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	SortBy        string
+	SortOrder     string
+	// MaxItems caps the number of versions returned. Zero returns all the versions.
+	MaxItems int
+}
+
+type AppVersion struct {
+	Version       string `json:"version"`
+	Tag           string `json:"tag,omitempty"`
+	Status        string `json:"status,omitempty"`
+	ReleaseStatus string `json:"release_status,omitempty"`
+	CurrentStage  string `json:"current_stage,omitempty"`
+	CreatedBy     string `json:"created_by,omitempty"`
+	Created       string `json:"created,omitempty"`
+}
+
+type ListAppVersionsResponse struct {
+	Versions   []AppVersion `json:"versions"`
+	Offset     int          `json:"offset"`
+	Limit      int          `json:"limit"`
+	Total      int          `json:"total"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
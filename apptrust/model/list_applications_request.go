@@ -0,0 +1,21 @@
+package model
+
+// ListApplicationsRequest holds the server-side filters used when listing applications.
+// Empty fields are not sent to the server.
+type ListApplicationsRequest struct {
+	ProjectKey          string
+	Labels              map[string]string
+	Owner               string
+	MaturityLevel       string
+	BusinessCriticality string
+	// MaxItems caps the number of applications returned. Zero returns all the applications.
+	MaxItems int
+}
+
+type ListApplicationsResponse struct {
+	Applications []AppDescriptor `json:"applications"`
+	Offset       int             `json:"offset"`
+	Limit        int             `json:"limit"`
+	Total        int             `json:"total"`
+	NextCursor   string          `json:"next_cursor,omitempty"`
+}
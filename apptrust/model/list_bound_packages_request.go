@@ -0,0 +1,26 @@
+package model
+
+// ListBoundPackagesRequest holds the filters applied when listing the packages bound to an application.
+// Empty fields are ignored.
+type ListBoundPackagesRequest struct {
+	Type string
+	// NamePattern is a glob pattern (e.g. "frontend-*") matched against the package name.
+	NamePattern string
+	// MaxItems caps the number of packages returned. Zero returns all the packages.
+	MaxItems int
+}
+
+type PackageBinding struct {
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	NumVersions   int    `json:"num_versions"`
+	LatestVersion string `json:"latest_version"`
+}
+
+type PackagesResponse struct {
+	Packages   []PackageBinding `json:"packages"`
+	Offset     int              `json:"offset"`
+	Limit      int              `json:"limit"`
+	Total      int              `json:"total"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
@@ -45,3 +45,22 @@ type PromoteAppVersionRequest struct {
 	CommonPromoteAppVersion
 	Stage string `json:"target_stage"`
 }
+
+// PromoteAppVersionResponse is the result of promoting or releasing an application version.
+type PromoteAppVersionResponse struct {
+	ApplicationKey               string             `json:"application_key"`
+	Version                      string             `json:"version"`
+	ProjectKey                   string             `json:"project_key,omitempty"`
+	SourceStage                  string             `json:"source_stage,omitempty"`
+	TargetStage                  string             `json:"target_stage,omitempty"`
+	PromotionType                string             `json:"promotion_type,omitempty"`
+	IncludedRepositoryKeys       []string           `json:"included_repository_keys,omitempty"`
+	ExcludedRepositoryKeys       []string           `json:"excluded_repository_keys,omitempty"`
+	ArtifactAdditionalProperties []ArtifactProperty `json:"artifact_additional_properties,omitempty"`
+	OverwriteStrategy            string             `json:"overwrite_strategy,omitempty"`
+	Status                       string             `json:"status,omitempty"`
+	CreatedBy                    string             `json:"created_by,omitempty"`
+	Created                      string             `json:"created,omitempty"`
+	CreatedMillis                int64              `json:"created_millis,omitempty"`
+	Messages                     []string           `json:"messages,omitempty"`
+}
@@ -0,0 +1,7 @@
+package model
+
+// SystemVersion is the version of the AppTrust service.
+type SystemVersion struct {
+	Version  string `json:"version"`
+	Revision string `json:"revision,omitempty"`
+}
@@ -0,0 +1,40 @@
+package model
+
+type VersionContentResponse struct {
+	ApplicationKey string              `json:"application_key"`
+	Version        string              `json:"version"`
+	Status         string              `json:"status"`
+	ReleaseStatus  string              `json:"release_status,omitempty"`
+	CurrentStage   string              `json:"current_stage,omitempty"`
+	Tag            string              `json:"tag,omitempty"`
+	CreatedBy      string              `json:"created_by,omitempty"`
+	Created        string              `json:"created,omitempty"`
+	Properties     map[string][]string `json:"properties,omitempty"`
+	Releasables    []Releasable        `json:"releasables"`
+}
+
+// Releasable is a package or a standalone artifact resolved into an application version.
+type Releasable struct {
+	Name          string               `json:"name"`
+	Version       string               `json:"version"`
+	PackageType   string               `json:"package_type"`
+	RepositoryKey string               `json:"repository_key,omitempty"`
+	SHA256        string               `json:"sha256,omitempty"`
+	Sources       []ReleasableSource   `json:"sources,omitempty"`
+	Artifacts     []ReleasableArtifact `json:"artifacts,omitempty"`
+}
+
+// ReleasableSource describes the build, release bundle or application version a releasable was taken from.
+type ReleasableSource struct {
+	Type          string `json:"type"`
+	Name          string `json:"name,omitempty"`
+	Version       string `json:"version,omitempty"`
+	ProjectKey    string `json:"project_key,omitempty"`
+	RepositoryKey string `json:"repository_key,omitempty"`
+}
+
+type ReleasableArtifact struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256,omitempty"`
+	Size   int64  `json:"size,omitempty"`
+}
@@ -0,0 +1,47 @@
+package model
+
+const (
+	DiffChangeAdded   = "added"
+	DiffChangeRemoved = "removed"
+	DiffChangeChanged = "changed"
+)
+
+// VersionDiff holds the differences between the contents of a base and a target application version.
+type VersionDiff struct {
+	Base      VersionRef     `json:"base"`
+	Target    VersionRef     `json:"target"`
+	Packages  []PackageDiff  `json:"packages"`
+	Artifacts []ArtifactDiff `json:"artifacts"`
+}
+
+type VersionRef struct {
+	ApplicationKey string `json:"application_key"`
+	Version        string `json:"version"`
+}
+
+// PackageDiff is a package that was added to, removed from or changed in the target version.
+// Packages are matched by type, name and version, and are changed when their sha256 differ. A package with a single
+// other version in each of the versions, e.g., after a version bump, is changed rather than removed and added.
+type PackageDiff struct {
+	Change        string `json:"change"`
+	PackageType   string `json:"package_type"`
+	Name          string `json:"name"`
+	BaseVersion   string `json:"base_version,omitempty"`
+	TargetVersion string `json:"target_version,omitempty"`
+	BaseSHA256    string `json:"base_sha256,omitempty"`
+	TargetSHA256  string `json:"target_sha256,omitempty"`
+}
+
+// ArtifactDiff is an artifact that was added to, removed from or changed in the target version.
+// Artifacts are matched by their path in their repository, so that the artifacts of versions that were promoted
+// to different repositories match, and are changed when their sha256 differ.
+type ArtifactDiff struct {
+	Change       string `json:"change"`
+	Path         string `json:"path"`
+	BaseSHA256   string `json:"base_sha256,omitempty"`
+	TargetSHA256 string `json:"target_sha256,omitempty"`
+}
+
+func (vd *VersionDiff) HasDifferences() bool {
+	return len(vd.Packages) > 0 || len(vd.Artifacts) > 0
+}
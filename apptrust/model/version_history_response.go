@@ -0,0 +1,27 @@
+package model
+
+const (
+	VersionEventPromotion = "promotion"
+	VersionEventRelease   = "release"
+	VersionEventRollback  = "rollback"
+)
+
+// VersionHistoryEvent is a single promotion, release or rollback of an application version.
+type VersionHistoryEvent struct {
+	EventType     string   `json:"event_type"`
+	SourceStage   string   `json:"source_stage,omitempty"`
+	TargetStage   string   `json:"target_stage,omitempty"`
+	PromotionType string   `json:"promotion_type,omitempty"`
+	Status        string   `json:"status"`
+	CreatedBy     string   `json:"created_by,omitempty"`
+	Created       string   `json:"created,omitempty"`
+	Messages      []string `json:"messages,omitempty"`
+}
+
+type VersionHistoryResponse struct {
+	Events     []VersionHistoryEvent `json:"events"`
+	Offset     int                   `json:"offset"`
+	Limit      int                   `json:"limit"`
+	Total      int                   `json:"total"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
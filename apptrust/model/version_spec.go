@@ -0,0 +1,17 @@
+package model
+
+const (
+	FilterTypePackage  = "package"
+	FilterTypeArtifact = "artifact"
+)
+
+// VersionSpec is the spec file of the sources and filters of an application version,
+// for the version-create and version-update-sources commands.
+type VersionSpec struct {
+	Artifacts      []CreateVersionArtifact      `json:"artifacts,omitempty"`
+	Packages       []CreateVersionPackage       `json:"packages,omitempty"`
+	Builds         []CreateVersionBuild         `json:"builds,omitempty"`
+	ReleaseBundles []CreateVersionReleaseBundle `json:"release_bundles,omitempty"`
+	Versions       []CreateVersionReference     `json:"versions,omitempty"`
+	Filters        *CreateVersionFilters        `json:"filters,omitempty"`
+}
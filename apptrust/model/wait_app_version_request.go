@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+const (
+	WaitStateCreated  = "created"
+	WaitStatePromoted = "promoted"
+	WaitStateFailed   = "failed"
+)
+
+var WaitStateValues = []string{
+	WaitStateCreated,
+	WaitStatePromoted,
+	WaitStateFailed,
+}
+
+// WaitAppVersionRequest describes the state an application version is expected to reach and how to poll for it.
+type WaitAppVersionRequest struct {
+	State string
+	// Stage is the stage the version is expected to be promoted to. Mandatory for WaitStatePromoted,
+	// and optionally limits WaitStateFailed to the promotions to this stage.
+	Stage string
+	// Timeout is the maximum time to wait for the version to reach the state.
+	Timeout time.Duration
+	// Interval is the initial time between polls. It doubles after each poll, up to a maximum.
+	Interval time.Duration
+}
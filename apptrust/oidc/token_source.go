@@ -0,0 +1,233 @@
+// Package oidc exchanges the ID tokens issued by CI systems, such as GitHub Actions and GitLab CI,
+// for short-lived JFrog access tokens.
+//
+// The ID token is exchanged with the OIDC integration of the JFrog Platform named by the provider.
+// Access tokens are cached until they expire, in memory and in a cache directory shared by the commands
+// of a CI job, so that each command doesn't exchange the ID token again.
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	commonCliConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/access"
+	accessServices "github.com/jfrog/jfrog-client-go/access/services"
+	clientConfig "github.com/jfrog/jfrog-client-go/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	//#nosec G101 -- not a credential, the grant type of the token exchange.
+	grantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	//#nosec G101 -- not a credential, the type of the exchanged token.
+	subjectTokenType = "urn:ietf:params:oauth:token-type:id_token"
+
+	// expiryMargin is subtracted from the expiry of the access tokens, so that a token doesn't expire during a command.
+	expiryMargin = time.Minute
+)
+
+// Exchange exchanges the ID token for an access token with the OIDC integration named providerName.
+// It returns the access token, and its lifetime, or zero if the server didn't return it.
+type Exchange func(ctx context.Context, serverDetails *commonCliConfig.ServerDetails, providerName, idToken string) (string, time.Duration, error)
+
+// TokenSource provides access tokens exchanged for an ID token. It implements apphttp.TokenSource,
+// and is safe for concurrent use.
+type TokenSource struct {
+	providerName string
+	idToken      string
+	cacheDir     string
+	exchange     Exchange
+	now          func() time.Time
+
+	mutex  sync.Mutex
+	cached map[string]cachedToken
+}
+
+// cachedToken is an access token with its expiry, as cached in memory and in the cache directory.
+type cachedToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// NewTokenSource returns a TokenSource that exchanges idToken with the OIDC integration named providerName.
+// The access tokens are cached in cacheDir, unless it is empty.
+func NewTokenSource(providerName, idToken, cacheDir string) *TokenSource {
+	return &TokenSource{
+		providerName: providerName,
+		idToken:      idToken,
+		cacheDir:     cacheDir,
+		exchange:     ExchangeWithAccess,
+		now:          time.Now,
+		cached:       map[string]cachedToken{},
+	}
+}
+
+// DefaultCacheDir returns the cache directory of the access tokens, in the JFrog CLI home directory.
+func DefaultCacheDir() (string, error) {
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, "apptrust", "oidc"), nil
+}
+
+// Token returns a cached access token for the server, unless refresh is true or the token expired,
+// in which case the ID token is exchanged for a new access token.
+func (s *TokenSource) Token(ctx context.Context, serverDetails *commonCliConfig.ServerDetails, refresh bool) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := s.cacheKey(serverDetails.Url)
+	if !refresh {
+		if token, ok := s.cachedToken(key); ok {
+			return token.AccessToken, nil
+		}
+	}
+
+	log.Debug("Exchanging the OIDC ID token for an access token with the", s.providerName, "provider...")
+	accessToken, lifetime, err := s.exchange(ctx, serverDetails, s.providerName, s.idToken)
+	if err != nil {
+		return "", err
+	}
+	token := cachedToken{AccessToken: accessToken}
+	if lifetime > 0 {
+		token.ExpiresAt = s.now().Add(lifetime)
+	}
+	s.cacheToken(key, token)
+	return accessToken, nil
+}
+
+// cacheKey identifies the access tokens of the server, provider and ID token, without revealing the ID token.
+func (s *TokenSource) cacheKey(serverUrl string) string {
+	hash := sha256.Sum256([]byte(serverUrl + "\n" + s.providerName + "\n" + s.idToken))
+	return hex.EncodeToString(hash[:])
+}
+
+// cachedToken returns the token cached in memory or in the cache directory, if it hasn't expired.
+func (s *TokenSource) cachedToken(key string) (cachedToken, bool) {
+	if token, ok := s.cached[key]; ok && s.isValid(token) {
+		return token, true
+	}
+	if s.cacheDir == "" {
+		return cachedToken{}, false
+	}
+	content, err := os.ReadFile(s.cachePath(key))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read the cached OIDC access token:", err.Error())
+		}
+		return cachedToken{}, false
+	}
+	var token cachedToken
+	if err = json.Unmarshal(content, &token); err != nil || !s.isValid(token) {
+		return cachedToken{}, false
+	}
+	s.cached[key] = token
+	return token, true
+}
+
+// isValid reports whether the token can be used. Tokens without an expiry are only cached in memory,
+// and are replaced when the server rejects them.
+func (s *TokenSource) isValid(token cachedToken) bool {
+	if token.AccessToken == "" {
+		return false
+	}
+	return token.ExpiresAt.IsZero() || s.now().Add(expiryMargin).Before(token.ExpiresAt)
+}
+
+func (s *TokenSource) cacheToken(key string, token cachedToken) {
+	s.cached[key] = token
+	if s.cacheDir == "" || token.ExpiresAt.IsZero() {
+		return
+	}
+	if err := s.writeCachedToken(key, token); err != nil {
+		log.Warn("Failed to cache the OIDC access token:", err.Error())
+	}
+}
+
+// writeCachedToken writes the token to the cache directory, readable only by the user.
+func (s *TokenSource) writeCachedToken(key string, token cachedToken) error {
+	if err := os.MkdirAll(s.cacheDir, 0o700); err != nil {
+		return err
+	}
+	content, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file and rename it, so that concurrent commands don't read a partial file.
+	file, err := os.CreateTemp(s.cacheDir, key+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), s.cachePath(key))
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+	return err
+}
+
+func (s *TokenSource) cachePath(key string) string {
+	return filepath.Join(s.cacheDir, key+".json")
+}
+
+// ExchangeWithAccess exchanges the ID token with the token exchange API of JFrog Access.
+func ExchangeWithAccess(ctx context.Context, serverDetails *commonCliConfig.ServerDetails, providerName, idToken string) (string, time.Duration, error) {
+	// The exchange is authenticated by the ID token only.
+	exchangeDetails := *serverDetails
+	exchangeDetails.AccessToken = ""
+	authDetails, err := exchangeDetails.CreateAccessAuthConfig()
+	if err != nil {
+		return "", 0, err
+	}
+	certsPath, err := coreutils.GetJfrogCertsDir()
+	if err != nil {
+		return "", 0, err
+	}
+	serviceConfig, err := clientConfig.NewConfigBuilder().
+		SetServiceDetails(authDetails).
+		SetCertificatesPath(certsPath).
+		SetInsecureTls(serverDetails.InsecureTls).
+		SetContext(ctx).
+		Build()
+	if err != nil {
+		return "", 0, err
+	}
+	manager, err := access.New(serviceConfig)
+	if err != nil {
+		return "", 0, err
+	}
+
+	response, err := manager.ExchangeOidcToken(accessServices.CreateOidcTokenParams{
+		GrantType:        grantType,
+		SubjectTokenType: subjectTokenType,
+		OidcTokenID:      idToken,
+		ProviderName:     providerName,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	if response.AccessToken == "" {
+		return "", 0, errorutils.CheckErrorf("the OIDC token exchange with the %s provider returned no access token", providerName)
+	}
+	var lifetime time.Duration
+	if response.ExpiresIn != nil {
+		lifetime = time.Duration(*response.ExpiresIn) * time.Second
+	}
+	return response.AccessToken, lifetime, nil
+}
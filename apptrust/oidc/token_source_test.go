@@ -0,0 +1,111 @@
+package oidc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/fakeserver"
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service/systems"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProvider = "github-actions"
+
+func startOidcServer(t *testing.T, options ...fakeserver.Option) (*fakeserver.Server, string) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	options = append([]fakeserver.Option{fakeserver.WithAccessToken("static-token"), fakeserver.WithOidcProvider(testProvider)}, options...)
+	server, serverDetails := fakeserver.StartTestServer(t, options...)
+	return server, serverDetails.Url
+}
+
+func TestTokenSource_Cache(t *testing.T) {
+	server, url := startOidcServer(t)
+	serverDetails := &coreConfig.ServerDetails{Url: url}
+	cacheDir := t.TempDir()
+	ctx := context.Background()
+
+	source := NewTokenSource(testProvider, "id-token", cacheDir)
+	token, err := source.Token(ctx, serverDetails, false)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token-1", token)
+
+	// The token is cached in memory, and in the cache directory for the next commands.
+	token, err = source.Token(ctx, serverDetails, false)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token-1", token)
+	token, err = NewTokenSource(testProvider, "id-token", cacheDir).Token(ctx, serverDetails, false)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token-1", token)
+	assert.Equal(t, 1, server.OidcExchangeCount())
+
+	files, err := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	info, err := os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Another ID token is exchanged rather than using the cached token.
+	token, err = NewTokenSource(testProvider, "other-id-token", cacheDir).Token(ctx, serverDetails, false)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token-2", token)
+
+	token, err = source.Token(ctx, serverDetails, true)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token-3", token)
+	assert.Equal(t, 3, server.OidcExchangeCount())
+}
+
+func TestTokenSource_Expiry(t *testing.T) {
+	server, url := startOidcServer(t, fakeserver.WithOidcTokenLifetime(10*time.Minute))
+	serverDetails := &coreConfig.ServerDetails{Url: url}
+	now := time.Now()
+	source := NewTokenSource(testProvider, "id-token", t.TempDir())
+	source.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err := source.Token(ctx, serverDetails, false)
+	require.NoError(t, err)
+	now = now.Add(8 * time.Minute)
+	_, err = source.Token(ctx, serverDetails, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.OidcExchangeCount())
+
+	// The token is replaced when it is about to expire.
+	now = now.Add(time.Minute + time.Second)
+	token, err := source.Token(ctx, serverDetails, false)
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-token-2", token)
+}
+
+func TestTokenSource_UnknownProvider(t *testing.T) {
+	_, url := startOidcServer(t)
+	source := NewTokenSource("unknown", "id-token", "")
+
+	_, err := source.Token(context.Background(), &coreConfig.ServerDetails{Url: url}, false)
+	assert.ErrorContains(t, err, "failed to exchange OIDC token")
+	assert.ErrorContains(t, err, "OIDC provider 'unknown' not found")
+}
+
+func TestTokenSource_RefreshOnUnauthorized(t *testing.T) {
+	server, url := startOidcServer(t)
+	source := NewTokenSource(testProvider, "id-token", t.TempDir())
+	serviceCtx, err := service.NewContext(coreConfig.ServerDetails{Url: url}, apphttp.WithTokenSource(source), apphttp.WithRetries(0))
+	require.NoError(t, err)
+	systemService := systems.NewSystemService()
+
+	_, err = systemService.Ping(serviceCtx)
+	require.NoError(t, err)
+	server.RevokeOidcTokens()
+	_, err = systemService.Ping(serviceCtx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.OidcExchangeCount())
+}
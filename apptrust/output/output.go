@@ -0,0 +1,250 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatJson  = "json"
+	FormatYaml  = "yaml"
+	FormatTable = "table"
+)
+
+var FormatValues = []string{
+	FormatJson,
+	FormatYaml,
+	FormatTable,
+}
+
+// ValidateFormat checks that format is one of FormatValues, or a Go text/template such as '{{.version}}'.
+// An empty format selects the JSON format.
+func ValidateFormat(format string) error {
+	if format == "" {
+		return nil
+	}
+	if isTemplate(format) {
+		_, err := parseTemplate(format)
+		return err
+	}
+	for _, value := range FormatValues {
+		if format == value {
+			return nil
+		}
+	}
+	return errorutils.CheckErrorf("invalid value for --format: '%s'. Allowed values: %s, or a Go template (e.g., '{{.version}}')",
+		format, coreutils.ListToText(FormatValues))
+}
+
+// Print writes value to the command output in the given format.
+// The table format renders lists of objects as one row per object, and single objects as field/value rows.
+func Print(format string, value interface{}) error {
+	return PrintWithTable(format, value, nil, "")
+}
+
+// PrintWithTable is like Print, but renders the table format from rows, a slice of structs with
+// 'col-name' tags as expected by coreutils.PrintTable. emptyMessage is printed when rows is empty.
+// Values of type json.RawMessage are printed as decoded JSON. An empty json.RawMessage prints nothing,
+// and one that is not valid JSON is printed as is.
+func PrintWithTable(format string, value interface{}, rows interface{}, emptyMessage string) error {
+	if raw, ok := value.(json.RawMessage); ok && len(bytes.TrimSpace(raw)) > 0 && !json.Valid(raw) {
+		log.Output(string(raw))
+		return nil
+	}
+	content, err := toJson(value)
+	if err != nil || content == nil {
+		return err
+	}
+
+	switch {
+	case format == "" || format == FormatJson:
+		return printJson(content)
+	case format == FormatYaml:
+		return printYaml(content)
+	case format == FormatTable && rows != nil:
+		return coreutils.PrintTable(rows, "", emptyMessage, false)
+	case format == FormatTable:
+		return printGenericTable(content, emptyMessage)
+	case isTemplate(format):
+		return printTemplate(format, content)
+	default:
+		return ValidateFormat(format)
+	}
+}
+
+// PrintItems writes the items of a list to the command output in the given format.
+// In the JSON format, which is the default, each item is written as soon as it is returned, so that long lists
+// are printed while they are fetched. The output is the same as that of Print with all the items.
+// Other formats print the items after all of them are returned. If the items end with an error,
+// the error is returned, and the JSON output of the items that were already written is incomplete.
+func PrintItems[T any](format string, items iter.Seq2[T, error]) error {
+	if format != "" && format != FormatJson {
+		collected := []T{}
+		for item, err := range items {
+			if err != nil {
+				return err
+			}
+			collected = append(collected, item)
+		}
+		return Print(format, collected)
+	}
+
+	// Each item is written once the next one is returned, or the list ends, to know whether it needs a trailing comma.
+	var previous string
+	for item, err := range items {
+		if err != nil {
+			return err
+		}
+		content, err := toJson(item)
+		if err != nil {
+			return err
+		}
+		var buffer bytes.Buffer
+		if err = json.Indent(&buffer, content, "  ", "  "); err != nil {
+			return errorutils.CheckErrorf("failed to format the output as JSON: %s", err.Error())
+		}
+		if previous == "" {
+			log.Output("[")
+		} else {
+			log.Output(previous + ",")
+		}
+		previous = "  " + buffer.String()
+	}
+	if previous == "" {
+		log.Output("[]")
+		return nil
+	}
+	log.Output(previous)
+	log.Output("]")
+	return nil
+}
+
+// toJson serializes value to JSON, so that all formats share the field names of the JSON output.
+// Nil values, such as the result of an asynchronous operation with no response body, produce no output.
+func toJson(value interface{}) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if reflectValue := reflect.ValueOf(value); reflectValue.Kind() == reflect.Pointer && reflectValue.IsNil() {
+		return nil, nil
+	}
+	if raw, ok := value.(json.RawMessage); ok {
+		if len(bytes.TrimSpace(raw)) == 0 {
+			return nil, nil
+		}
+		return raw, nil
+	}
+	content, err := json.Marshal(value)
+	return content, errorutils.CheckError(err)
+}
+
+func printJson(content json.RawMessage) error {
+	var buffer bytes.Buffer
+	if err := json.Indent(&buffer, content, "", "  "); err != nil {
+		return errorutils.CheckErrorf("failed to format the output as JSON: %s", err.Error())
+	}
+	log.Output(buffer.String())
+	return nil
+}
+
+func printYaml(content json.RawMessage) error {
+	out, err := marshalYaml(content)
+	if err != nil {
+		return err
+	}
+	log.Output(strings.TrimSuffix(string(out), "\n"))
+	return nil
+}
+
+// MarshalYaml returns the YAML of value, with the field names and the field order of its JSON encoding.
+// Strings that YAML would read as other types, such as "1.0", are quoted.
+func MarshalYaml(value interface{}) ([]byte, error) {
+	content, err := toJson(value)
+	if err != nil || content == nil {
+		return nil, err
+	}
+	return marshalYaml(content)
+}
+
+func marshalYaml(content json.RawMessage) ([]byte, error) {
+	node, err := toYamlNode(content)
+	if err != nil {
+		return nil, err
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to format the output as YAML: %s", err.Error())
+	}
+	return out, nil
+}
+
+// toYamlNode parses JSON content into a YAML node tree, keeping the order of the fields.
+// Since JSON is valid YAML, the nodes are created with flow and quoted styles, which are reset
+// so that the output uses the block style.
+func toYamlNode(content json.RawMessage) (*yaml.Node, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, errorutils.CheckErrorf("failed to format the output as YAML: %s", err.Error())
+	}
+	resetYamlStyle(&document)
+	if document.Kind == yaml.DocumentNode && len(document.Content) == 1 {
+		return document.Content[0], nil
+	}
+	return &document, nil
+}
+
+func resetYamlStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYamlStyle(child)
+	}
+}
+
+func isTemplate(format string) bool {
+	return strings.Contains(format, "{{")
+}
+
+func parseTemplate(format string) (*template.Template, error) {
+	tmpl, err := template.New("format").Funcs(template.FuncMap{
+		"json": func(value interface{}) (string, error) {
+			content, err := json.Marshal(value)
+			return string(content), err
+		},
+	}).Parse(format)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid --format template: %s", err.Error())
+	}
+	return tmpl, nil
+}
+
+// printTemplate executes a Go template on the decoded JSON output,
+// so fields are referenced by their JSON names (e.g., '{{.application_key}}').
+func printTemplate(format string, content json.RawMessage) error {
+	tmpl, err := parseTemplate(format)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var data interface{}
+	if err = decoder.Decode(&data); err != nil {
+		return errorutils.CheckError(err)
+	}
+
+	var buffer bytes.Buffer
+	if err = tmpl.Execute(&buffer, data); err != nil {
+		return errorutils.CheckErrorf("failed to execute the --format template: %s", err.Error())
+	}
+	log.Output(buffer.String())
+	return nil
+}
@@ -0,0 +1,212 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type testItem struct {
+	Key    string            `json:"key"`
+	Size   int64             `json:"size"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type testRow struct {
+	Key string `col-name:"Key"`
+}
+
+func captureOutput(t *testing.T, print func() error) string {
+	var buffer bytes.Buffer
+	previousLogger := log.GetLogger()
+	logger := log.NewLogger(log.INFO, nil)
+	logger.SetOutputWriter(&buffer)
+	log.SetLogger(logger)
+	defer func() {
+		log.SetLogger(previousLogger)
+		logger.SetOutputWriter(nil)
+	}()
+
+	assert.NoError(t, print())
+	return buffer.String()
+}
+
+func TestPrint(t *testing.T) {
+	items := []testItem{
+		{Key: "app-1", Size: 1234567, Labels: map[string]string{"env": "prod"}},
+		{Key: "app-2", Size: 5},
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "json",
+			format:   FormatJson,
+			value:    items[1],
+			expected: "{\n  \"key\": \"app-2\",\n  \"size\": 5\n}\n",
+		},
+		{
+			name:     "default format is json",
+			format:   "",
+			value:    items[1],
+			expected: "{\n  \"key\": \"app-2\",\n  \"size\": 5\n}\n",
+		},
+		{
+			name:     "yaml keeps field order and numbers",
+			format:   FormatYaml,
+			value:    items,
+			expected: "- key: app-1\n  size: 1234567\n  labels:\n    env: prod\n- key: app-2\n  size: 5\n",
+		},
+		{
+			name:     "template uses json field names",
+			format:   "{{range .}}{{.key}}={{.size}};{{end}}",
+			value:    items,
+			expected: "app-1=1234567;app-2=5;\n",
+		},
+		{
+			name:     "template json function",
+			format:   "{{json .labels}}",
+			value:    items[0],
+			expected: "{\"env\":\"prod\"}\n",
+		},
+		{
+			name:     "raw json is reformatted",
+			format:   FormatYaml,
+			value:    json.RawMessage(`{"b":1,"a":"x"}`),
+			expected: "b: 1\na: x\n",
+		},
+		{
+			name:     "raw text is printed as is",
+			format:   FormatYaml,
+			value:    json.RawMessage("OK"),
+			expected: "OK\n",
+		},
+		{
+			name:     "nil pointer prints nothing",
+			format:   FormatJson,
+			value:    (*testItem)(nil),
+			expected: "",
+		},
+		{
+			name:     "empty raw json prints nothing",
+			format:   FormatJson,
+			value:    json.RawMessage(""),
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureOutput(t, func() error {
+				return Print(tt.format, tt.value)
+			})
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
+
+func TestPrint_Table(t *testing.T) {
+	t.Run("list of objects", func(t *testing.T) {
+		out := captureOutput(t, func() error {
+			return Print(FormatTable, []testItem{
+				{Key: "app-1", Size: 10, Labels: map[string]string{"env": "prod"}},
+				{Key: "app-2", Size: 5},
+			})
+		})
+		assert.Contains(t, out, "KEY")
+		assert.Contains(t, out, "LABELS")
+		assert.Contains(t, out, "app-1")
+		assert.Contains(t, out, "{env: prod}")
+		assert.Contains(t, out, "app-2")
+	})
+
+	t.Run("single object", func(t *testing.T) {
+		out := captureOutput(t, func() error {
+			return Print(FormatTable, testItem{Key: "app-1", Size: 10})
+		})
+		assert.Contains(t, out, "FIELD")
+		assert.Contains(t, out, "size")
+		assert.Contains(t, out, "10")
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		out := captureOutput(t, func() error {
+			return Print(FormatTable, []testItem{})
+		})
+		assert.Equal(t, defaultEmptyTableMessage+"\n", out)
+	})
+
+	t.Run("custom rows are used for the table format only", func(t *testing.T) {
+		out := captureOutput(t, func() error {
+			return PrintWithTable(FormatJson, []testItem{{Key: "app-1"}}, []testRow{{Key: "row-1"}}, "")
+		})
+		assert.Contains(t, out, "\"app-1\"")
+		assert.NotContains(t, out, "row-1")
+	})
+}
+
+func TestPrintItems(t *testing.T) {
+	items := []testItem{
+		{Key: "app-1", Size: 1234567, Labels: map[string]string{"env": "prod"}},
+		{Key: "app-2", Size: 5},
+	}
+
+	for _, format := range []string{"", FormatJson, FormatYaml, FormatTable, "{{range .}}{{.key}} {{end}}"} {
+		for _, list := range [][]testItem{items, items[:1], {}} {
+			t.Run(fmt.Sprintf("%s with %d items", format, len(list)), func(t *testing.T) {
+				expected := captureOutput(t, func() error {
+					return Print(format, list)
+				})
+				out := captureOutput(t, func() error {
+					return PrintItems(format, apphttp.Sequence(list, nil))
+				})
+				assert.Equal(t, expected, out)
+			})
+		}
+	}
+}
+
+func TestPrintItems_Error(t *testing.T) {
+	listErr := errors.New("list error")
+	for _, format := range []string{FormatJson, FormatYaml} {
+		t.Run(format, func(t *testing.T) {
+			out := captureOutput(t, func() error {
+				assert.ErrorIs(t, PrintItems(format, apphttp.Sequence[testItem](nil, listErr)), listErr)
+				return nil
+			})
+			assert.Empty(t, out)
+		})
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	for _, format := range append(FormatValues, "", "{{.key}}") {
+		assert.NoError(t, ValidateFormat(format))
+	}
+	assert.ErrorContains(t, ValidateFormat("xml"), "invalid value for --format: 'xml'")
+	assert.ErrorContains(t, ValidateFormat("{{.key"), "invalid --format template")
+}
+
+func TestMarshalYaml(t *testing.T) {
+	value := struct {
+		Name    string            `json:"name"`
+		Version string            `json:"version"`
+		Started string            `json:"started"`
+		Labels  map[string]string `json:"labels,omitempty"`
+		Tags    []string          `json:"tags"`
+	}{Name: "app", Version: "1.0", Started: "2024-01-31", Labels: map[string]string{"env": "prod"}, Tags: []string{"a", "true"}}
+
+	out, err := MarshalYaml(value)
+	assert.NoError(t, err)
+	assert.Equal(t, "name: app\nversion: \"1.0\"\nstarted: \"2024-01-31\"\nlabels:\n    env: prod\ntags:\n    - a\n    - \"true\"\n", string(out))
+}
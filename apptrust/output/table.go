@@ -0,0 +1,108 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultEmptyTableMessage = "No results were found."
+
+// printGenericTable renders JSON content as a table.
+// A list of objects is rendered with a column per field, in the order the fields first appear.
+// A single object is rendered as field/value rows.
+func printGenericTable(content json.RawMessage, emptyMessage string) error {
+	node, err := toYamlNode(content)
+	if err != nil {
+		return err
+	}
+
+	tableWriter := table.NewWriter()
+	tableWriter.SetStyle(table.StyleLight)
+	switch node.Kind {
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			if emptyMessage == "" {
+				emptyMessage = defaultEmptyTableMessage
+			}
+			log.Output(emptyMessage)
+			return nil
+		}
+		appendListRows(tableWriter, node)
+	case yaml.MappingNode:
+		tableWriter.AppendHeader(table.Row{"Field", "Value"})
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			tableWriter.AppendRow(table.Row{node.Content[i].Value, formatCell(node.Content[i+1])})
+		}
+	default:
+		log.Output(formatCell(node))
+		return nil
+	}
+	log.Output(tableWriter.Render())
+	return nil
+}
+
+func appendListRows(tableWriter table.Writer, node *yaml.Node) {
+	var columns []string
+	columnIndexes := make(map[string]int)
+	for _, item := range node.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i < len(item.Content); i += 2 {
+			key := item.Content[i].Value
+			if _, found := columnIndexes[key]; !found {
+				columnIndexes[key] = len(columns)
+				columns = append(columns, key)
+			}
+		}
+	}
+
+	if len(columns) == 0 {
+		// A list of scalars is rendered as a single column.
+		tableWriter.AppendHeader(table.Row{"Value"})
+		for _, item := range node.Content {
+			tableWriter.AppendRow(table.Row{formatCell(item)})
+		}
+		return
+	}
+
+	header := make(table.Row, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+	tableWriter.AppendHeader(header)
+	for _, item := range node.Content {
+		row := make(table.Row, len(columns))
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			row[columnIndexes[item.Content[i].Value]] = formatCell(item.Content[i+1])
+		}
+		tableWriter.AppendRow(row)
+	}
+}
+
+// formatCell renders a scalar as is, and nested lists and objects in the compact YAML flow style.
+func formatCell(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return ""
+		}
+		return node.Value
+	case yaml.SequenceNode, yaml.MappingNode:
+		flowNode := *node
+		flowNode.Style = yaml.FlowStyle
+		out, err := yaml.Marshal(&flowNode)
+		if err != nil {
+			log.Debug(errorutils.CheckError(err))
+			return ""
+		}
+		return strings.TrimSpace(string(out))
+	default:
+		return ""
+	}
+}
@@ -3,20 +3,26 @@ package applications
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
-	"github.com/jfrog/jfrog-client-go/utils/log"
 
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 )
 
 type ApplicationService interface {
-	CreateApplication(ctx service.Context, requestBody *model.AppDescriptor) error
-	UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) error
+	CreateApplication(ctx service.Context, requestBody *model.AppDescriptor) (*model.AppDescriptor, error)
+	UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) (*model.AppDescriptor, error)
 	DeleteApplication(ctx service.Context, applicationKey string) error
+	ListApplications(ctx service.Context, request *model.ListApplicationsRequest) iter.Seq2[model.AppDescriptor, error]
+	GetApplication(ctx service.Context, applicationKey string) (*model.AppDescriptor, error)
 }
 
 type applicationService struct{}
@@ -25,37 +31,33 @@ func NewApplicationService() ApplicationService {
 	return &applicationService{}
 }
 
-func (as *applicationService) CreateApplication(ctx service.Context, requestBody *model.AppDescriptor) error {
+func (as *applicationService) CreateApplication(ctx service.Context, requestBody *model.AppDescriptor) (*model.AppDescriptor, error) {
 	response, responseBody, err := ctx.GetHttpClient().Post("/v1/applications", requestBody, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if response.StatusCode != http.StatusCreated {
-		return errorutils.CheckErrorf("failed to create an application. Status code: %d.\n%s",
-			response.StatusCode, responseBody)
+		return nil, errorutils.CheckError(apphttp.NewApptrustError("failed to create an application", response, responseBody))
 	}
 
-	log.Info(fmt.Sprintf("Application \"%s\" created successfully.", requestBody.ApplicationKey))
-	log.Output(string(responseBody))
-	return nil
+	application := *requestBody
+	return apphttp.DecodeResponse("create application", responseBody, &application)
 }
 
-func (as *applicationService) UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) error {
+func (as *applicationService) UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) (*model.AppDescriptor, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s", requestBody.ApplicationKey)
 	response, responseBody, err := ctx.GetHttpClient().Patch(endpoint, requestBody, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return errorutils.CheckErrorf("failed to update application. Status code: %d.\n%s",
-			response.StatusCode, responseBody)
+		return nil, errorutils.CheckError(apphttp.NewApptrustError("failed to update application", response, responseBody))
 	}
 
-	log.Info(fmt.Sprintf("Application \"%s\" updated successfully.", requestBody.ApplicationKey))
-	log.Output(string(responseBody))
-	return nil
+	application := *requestBody
+	return apphttp.DecodeResponse("update application", responseBody, &application)
 }
 
 func (as *applicationService) DeleteApplication(ctx service.Context, applicationKey string) error {
@@ -66,10 +68,70 @@ func (as *applicationService) DeleteApplication(ctx service.Context, application
 	}
 
 	if response.StatusCode != http.StatusNoContent {
-		return errorutils.CheckErrorf("failed to delete application. Status code: %d.\n%s",
-			response.StatusCode, responseBody)
+		return errorutils.CheckError(apphttp.NewApptrustError("failed to delete application", response, responseBody))
 	}
 
-	log.Info(fmt.Sprintf("Application \"%s\" deleted successfully.", applicationKey))
 	return nil
 }
+
+// ListApplications returns an iterator over the applications that match the request.
+// Pages are requested from the server as the applications are consumed.
+func (as *applicationService) ListApplications(ctx service.Context, request *model.ListApplicationsRequest) iter.Seq2[model.AppDescriptor, error] {
+	options := apphttp.PageOptions{Params: buildListApplicationsParams(request), Operation: "failed to list applications"}
+	if request != nil {
+		options.MaxItems = request.MaxItems
+	}
+	return apphttp.Paginate(ctx.GetHttpClient(), "/v1/applications", options, func(body []byte) (*apphttp.Page[model.AppDescriptor], error) {
+		var page model.ListApplicationsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the list applications response: %s", err.Error())
+		}
+		return &apphttp.Page[model.AppDescriptor]{Items: page.Applications, Total: page.Total, NextCursor: page.NextCursor}, nil
+	})
+}
+
+func (as *applicationService) GetApplication(ctx service.Context, applicationKey string) (*model.AppDescriptor, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s", applicationKey)
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckError(apphttp.NewApptrustError("failed to get application", response, responseBody))
+	}
+
+	descriptor := new(model.AppDescriptor)
+	if err = json.Unmarshal(responseBody, descriptor); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the application response: %s", err.Error())
+	}
+	return descriptor, nil
+}
+
+func buildListApplicationsParams(request *model.ListApplicationsRequest) map[string]string {
+	params := map[string]string{}
+	if request == nil {
+		return params
+	}
+	if request.ProjectKey != "" {
+		params["project_key"] = request.ProjectKey
+	}
+	if request.Owner != "" {
+		params["owner"] = request.Owner
+	}
+	if request.MaturityLevel != "" {
+		params["maturity_level"] = request.MaturityLevel
+	}
+	if request.BusinessCriticality != "" {
+		params["criticality"] = request.BusinessCriticality
+	}
+	if len(request.Labels) > 0 {
+		labels := make([]string, 0, len(request.Labels))
+		for key, value := range request.Labels {
+			labels = append(labels, key+":"+value)
+		}
+		sort.Strings(labels)
+		params["label"] = strings.Join(labels, ",")
+	}
+	return params
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	mockhttp "github.com/jfrog/jfrog-cli-application/apptrust/http/mocks"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
@@ -27,12 +28,19 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 			mockError:     nil,
 			expectedError: "",
 		},
+		{
+			name:          "CreateApplication successful without a response body",
+			mockResponse:  &http.Response{StatusCode: http.StatusCreated},
+			mockBody:      nil,
+			mockError:     nil,
+			expectedError: "",
+		},
 		{
 			name:          "CreateApplication failed with non-201 status code",
 			mockResponse:  &http.Response{StatusCode: http.StatusBadRequest},
 			mockBody:      []byte(""),
 			mockError:     nil,
-			expectedError: "failed to create an application. Status code: 400.\n",
+			expectedError: "failed to create an application. Status code: 400.",
 		},
 		{
 			name:          "CreateApplication failed with error",
@@ -55,12 +63,193 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
 
 			as := NewApplicationService()
-			err := as.CreateApplication(mockCtx, &model.AppDescriptor{ApplicationKey: "app-123"})
+			application, err := as.CreateApplication(mockCtx, &model.AppDescriptor{ApplicationKey: "app-123"})
 
 			if tt.expectedError != "" {
 				assert.EqualError(t, err, tt.expectedError)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, &model.AppDescriptor{ApplicationKey: "app-123"}, application)
+			}
+		})
+	}
+}
+
+func TestApplicationService_ListApplications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &model.ListApplicationsRequest{
+		ProjectKey:    "proj",
+		Labels:        map[string]string{"team": "core", "env": "prod"},
+		MaturityLevel: model.MaturityLevelProduction,
+	}
+	expectedFilters := map[string]string{
+		"project_key":    "proj",
+		"label":          "env:prod,team:core",
+		"maturity_level": "production",
+	}
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	gomock.InOrder(
+		mockHttpClient.EXPECT().Get("/v1/applications", gomock.Any()).
+			DoAndReturn(func(_ string, params map[string]string) (*http.Response, []byte, error) {
+				assertListParams(t, expectedFilters, params, "0")
+				return &http.Response{StatusCode: http.StatusOK},
+					[]byte(`{"applications":[{"application_key":"app-1"},{"application_key":"app-2"}],"offset":0,"total":3}`), nil
+			}),
+		mockHttpClient.EXPECT().Get("/v1/applications", gomock.Any()).
+			DoAndReturn(func(_ string, params map[string]string) (*http.Response, []byte, error) {
+				assertListParams(t, expectedFilters, params, "2")
+				return &http.Response{StatusCode: http.StatusOK},
+					[]byte(`{"applications":[{"application_key":"app-3"}],"offset":2,"total":3}`), nil
+			}),
+	)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+	as := NewApplicationService()
+	applications, err := apphttp.Collect(as.ListApplications(mockCtx, request))
+	assert.NoError(t, err)
+	assert.Equal(t, []model.AppDescriptor{
+		{ApplicationKey: "app-1"},
+		{ApplicationKey: "app-2"},
+		{ApplicationKey: "app-3"},
+	}, applications)
+}
+
+func TestApplicationService_ListApplications_MaxItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Get("/v1/applications", map[string]string{"offset": "0", "limit": "2"}).
+		Return(&http.Response{StatusCode: http.StatusOK},
+			[]byte(`{"applications":[{"application_key":"app-1"},{"application_key":"app-2"}],"offset":0,"total":3}`), nil).
+		Times(1)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+	applications, err := apphttp.Collect(NewApplicationService().ListApplications(mockCtx, &model.ListApplicationsRequest{MaxItems: 2}))
+	assert.NoError(t, err)
+	assert.Equal(t, []model.AppDescriptor{{ApplicationKey: "app-1"}, {ApplicationKey: "app-2"}}, applications)
+}
+
+func TestApplicationService_ListApplications_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  *http.Response
+		mockBody      []byte
+		mockError     error
+		expectedError string
+	}{
+		{
+			name:          "failed with non-200 status code",
+			mockResponse:  &http.Response{StatusCode: http.StatusForbidden},
+			mockBody:      []byte("forbidden"),
+			expectedError: "failed to list applications. Status code: 403.\nforbidden",
+		},
+		{
+			name:          "invalid response body",
+			mockResponse:  &http.Response{StatusCode: http.StatusOK},
+			mockBody:      []byte("not-json"),
+			expectedError: "failed to parse the list applications response",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http error"),
+			expectedError: "http error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications", gomock.Any()).Return(tt.mockResponse, tt.mockBody, tt.mockError)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			as := NewApplicationService()
+			applications, err := apphttp.Collect(as.ListApplications(mockCtx, &model.ListApplicationsRequest{}))
+			assert.ErrorContains(t, err, tt.expectedError)
+			assert.Nil(t, applications)
+		})
+	}
+}
+
+func assertListParams(t *testing.T, expectedFilters, params map[string]string, expectedOffset string) {
+	for key, value := range expectedFilters {
+		assert.Equal(t, value, params[key])
+	}
+	assert.Equal(t, expectedOffset, params["offset"])
+	assert.Equal(t, "100", params["limit"])
+}
+
+func TestApplicationService_GetApplication(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockResponse     *http.Response
+		mockBody         []byte
+		mockError        error
+		expectedResponse *model.AppDescriptor
+		expectedError    string
+	}{
+		{
+			name:         "GetApplication successful",
+			mockResponse: &http.Response{StatusCode: http.StatusOK},
+			mockBody:     []byte(`{"application_key":"app-123","application_name":"App","project_key":"proj","user_owners":["john"]}`),
+			expectedResponse: &model.AppDescriptor{
+				ApplicationKey:  "app-123",
+				ApplicationName: "App",
+				ProjectKey:      "proj",
+				UserOwners:      &[]string{"john"},
+			},
+		},
+		{
+			name:          "GetApplication not found",
+			mockResponse:  &http.Response{StatusCode: http.StatusNotFound},
+			mockBody:      []byte("not found"),
+			expectedError: "failed to get application. Status code: 404.\nnot found",
+		},
+		{
+			name:          "GetApplication invalid response body",
+			mockResponse:  &http.Response{StatusCode: http.StatusOK},
+			mockBody:      []byte("not-json"),
+			expectedError: "failed to parse the application response",
+		},
+		{
+			name:          "GetApplication failed with error",
+			mockError:     errors.New("http error"),
+			expectedError: "http error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/app-123", nil).Return(tt.mockResponse, tt.mockBody, tt.mockError)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			as := NewApplicationService()
+			descriptor, err := as.GetApplication(mockCtx, "app-123")
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				assert.Nil(t, descriptor)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, descriptor)
 			}
 		})
 	}
@@ -10,6 +10,7 @@
 package mock_applications
 
 import (
+	iter "iter"
 	reflect "reflect"
 
 	model "github.com/jfrog/jfrog-cli-application/apptrust/model"
@@ -42,11 +43,12 @@ func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
 }
 
 // CreateApplication mocks base method.
-func (m *MockApplicationService) CreateApplication(ctx service.Context, requestBody *model.AppDescriptor) error {
+func (m *MockApplicationService) CreateApplication(ctx service.Context, requestBody *model.AppDescriptor) (*model.AppDescriptor, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateApplication", ctx, requestBody)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.AppDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateApplication indicates an expected call of CreateApplication.
@@ -69,12 +71,42 @@ func (mr *MockApplicationServiceMockRecorder) DeleteApplication(ctx, application
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockApplicationService)(nil).DeleteApplication), ctx, applicationKey)
 }
 
+// GetApplication mocks base method.
+func (m *MockApplicationService) GetApplication(ctx service.Context, applicationKey string) (*model.AppDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplication", ctx, applicationKey)
+	ret0, _ := ret[0].(*model.AppDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplication indicates an expected call of GetApplication.
+func (mr *MockApplicationServiceMockRecorder) GetApplication(ctx, applicationKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockApplicationService)(nil).GetApplication), ctx, applicationKey)
+}
+
+// ListApplications mocks base method.
+func (m *MockApplicationService) ListApplications(ctx service.Context, request *model.ListApplicationsRequest) iter.Seq2[model.AppDescriptor, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplications", ctx, request)
+	ret0, _ := ret[0].(iter.Seq2[model.AppDescriptor, error])
+	return ret0
+}
+
+// ListApplications indicates an expected call of ListApplications.
+func (mr *MockApplicationServiceMockRecorder) ListApplications(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockApplicationService)(nil).ListApplications), ctx, request)
+}
+
 // UpdateApplication mocks base method.
-func (m *MockApplicationService) UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) error {
+func (m *MockApplicationService) UpdateApplication(ctx service.Context, requestBody *model.AppDescriptor) (*model.AppDescriptor, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateApplication", ctx, requestBody)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.AppDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateApplication indicates an expected call of UpdateApplication.
@@ -3,6 +3,8 @@ package service
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	goContext "context"
+
 	"github.com/jfrog/jfrog-cli-application/apptrust/http"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
@@ -10,6 +12,10 @@ import (
 type Context interface {
 	GetServerDetails() coreConfig.ServerDetails
 	GetHttpClient() http.ApptrustHttpClient
+	// GetContext returns the context of the HTTP client, which is canceled on timeout or when the user interrupts the command.
+	GetContext() goContext.Context
+	// GetLogger returns the logger of the HTTP client, which receives the diagnostic messages of the services.
+	GetLogger() http.Logger
 }
 
 type context struct {
@@ -25,8 +31,16 @@ func (c *context) GetHttpClient() http.ApptrustHttpClient {
 	return c.HttpClient
 }
 
-func NewContext(serverDetails coreConfig.ServerDetails) (Context, error) {
-	httpClient, err := http.NewAppHttpClient(&serverDetails)
+func (c *context) GetContext() goContext.Context {
+	return c.HttpClient.GetContext()
+}
+
+func (c *context) GetLogger() http.Logger {
+	return c.HttpClient.GetLogger()
+}
+
+func NewContext(serverDetails coreConfig.ServerDetails, options ...http.ClientOption) (Context, error) {
+	httpClient, err := http.NewAppHttpClient(&serverDetails, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	goContext "context"
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/http"
@@ -40,3 +41,18 @@ func TestNewContext(t *testing.T) {
 	assert.Equal(t, serverDetails, ctx.GetServerDetails())
 	assert.NotNil(t, ctx.GetHttpClient())
 }
+
+func TestNewContext_WithContext(t *testing.T) {
+	serverDetails := coreConfig.ServerDetails{
+		Url: "https://example.com",
+	}
+	ctx, err := NewContext(serverDetails)
+	assert.NoError(t, err)
+	assert.Equal(t, goContext.Background(), ctx.GetContext())
+
+	requestCtx, cancel := goContext.WithCancel(goContext.Background())
+	defer cancel()
+	ctx, err = NewContext(serverDetails, http.WithContext(requestCtx))
+	assert.NoError(t, err)
+	assert.Equal(t, requestCtx, ctx.GetContext())
+}
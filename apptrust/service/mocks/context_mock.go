@@ -10,6 +10,7 @@
 package mock_service
 
 import (
+	context "context"
 	reflect "reflect"
 
 	http "github.com/jfrog/jfrog-cli-application/apptrust/http"
@@ -41,6 +42,20 @@ func (m *MockContext) EXPECT() *MockContextMockRecorder {
 	return m.recorder
 }
 
+// GetContext mocks base method.
+func (m *MockContext) GetContext() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContext")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// GetContext indicates an expected call of GetContext.
+func (mr *MockContextMockRecorder) GetContext() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContext", reflect.TypeOf((*MockContext)(nil).GetContext))
+}
+
 // GetHttpClient mocks base method.
 func (m *MockContext) GetHttpClient() http.ApptrustHttpClient {
 	m.ctrl.T.Helper()
@@ -55,6 +70,20 @@ func (mr *MockContextMockRecorder) GetHttpClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpClient", reflect.TypeOf((*MockContext)(nil).GetHttpClient))
 }
 
+// GetLogger mocks base method.
+func (m *MockContext) GetLogger() http.Logger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogger")
+	ret0, _ := ret[0].(http.Logger)
+	return ret0
+}
+
+// GetLogger indicates an expected call of GetLogger.
+func (mr *MockContextMockRecorder) GetLogger() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogger", reflect.TypeOf((*MockContext)(nil).GetLogger))
+}
+
 // GetServerDetails mocks base method.
 func (m *MockContext) GetServerDetails() config.ServerDetails {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@
 package mock_packages
 
 import (
+	iter "iter"
 	reflect "reflect"
 
 	model "github.com/jfrog/jfrog-cli-application/apptrust/model"
@@ -42,11 +43,12 @@ func (m *MockPackageService) EXPECT() *MockPackageServiceMockRecorder {
 }
 
 // BindPackage mocks base method.
-func (m *MockPackageService) BindPackage(ctx service.Context, applicationKey string, request *model.BindPackageRequest) error {
+func (m *MockPackageService) BindPackage(ctx service.Context, applicationKey string, request *model.BindPackageRequest) (*model.BindPackageResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BindPackage", ctx, applicationKey, request)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.BindPackageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // BindPackage indicates an expected call of BindPackage.
@@ -55,6 +57,20 @@ func (mr *MockPackageServiceMockRecorder) BindPackage(ctx, applicationKey, reque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindPackage", reflect.TypeOf((*MockPackageService)(nil).BindPackage), ctx, applicationKey, request)
 }
 
+// ListBoundPackages mocks base method.
+func (m *MockPackageService) ListBoundPackages(ctx service.Context, applicationKey string, request *model.ListBoundPackagesRequest) iter.Seq2[model.PackageBinding, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBoundPackages", ctx, applicationKey, request)
+	ret0, _ := ret[0].(iter.Seq2[model.PackageBinding, error])
+	return ret0
+}
+
+// ListBoundPackages indicates an expected call of ListBoundPackages.
+func (mr *MockPackageServiceMockRecorder) ListBoundPackages(ctx, applicationKey, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBoundPackages", reflect.TypeOf((*MockPackageService)(nil).ListBoundPackages), ctx, applicationKey, request)
+}
+
 // UnbindPackage mocks base method.
 func (m *MockPackageService) UnbindPackage(ctx service.Context, applicationKey, pkgType, pkgName, pkgVersion string) error {
 	m.ctrl.T.Helper()
@@ -3,18 +3,24 @@ package packages
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
+	"path"
+	"strings"
 
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
-	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 type PackageService interface {
-	BindPackage(ctx service.Context, applicationKey string, request *model.BindPackageRequest) error
+	BindPackage(ctx service.Context, applicationKey string, request *model.BindPackageRequest) (*model.BindPackageResponse, error)
 	UnbindPackage(ctx service.Context, applicationKey, pkgType, pkgName, pkgVersion string) error
+	ListBoundPackages(ctx service.Context, applicationKey string, request *model.ListBoundPackagesRequest) iter.Seq2[model.PackageBinding, error]
 }
 
 type packageService struct{}
@@ -23,21 +29,19 @@ func NewPackageService() PackageService {
 	return &packageService{}
 }
 
-func (ps *packageService) BindPackage(ctx service.Context, applicationKey string, request *model.BindPackageRequest) error {
+func (ps *packageService) BindPackage(ctx service.Context, applicationKey string, request *model.BindPackageRequest) (*model.BindPackageResponse, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s/packages", applicationKey)
 	response, responseBody, err := ctx.GetHttpClient().Post(endpoint, request, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if response.StatusCode != http.StatusCreated {
-		return fmt.Errorf("failed to bind package. Status code: %d.\n%s",
-			response.StatusCode, responseBody)
+		return nil, apphttp.NewApptrustError("failed to bind package", response, responseBody)
 	}
 
-	log.Info("Package bound successfully.")
-	log.Output(string(responseBody))
-	return nil
+	return apphttp.DecodeResponse("bind package", responseBody,
+		&model.BindPackageResponse{ApplicationKey: applicationKey, Type: request.Type, Name: request.Name, Version: request.Version})
 }
 
 func (ps *packageService) UnbindPackage(ctx service.Context, applicationKey, pkgType, pkgName, pkgVersion string) error {
@@ -48,10 +52,56 @@ func (ps *packageService) UnbindPackage(ctx service.Context, applicationKey, pkg
 	}
 
 	if response.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("failed to unbind package. Status code: %d.\n%s",
-			response.StatusCode, responseBody)
+		return apphttp.NewApptrustError("failed to unbind package", response, responseBody)
 	}
 
-	log.Info("Package unbound successfully.")
 	return nil
 }
+
+// ListBoundPackages returns an iterator over the packages bound to the application that match the request.
+// Pages are requested from the server as the packages are consumed.
+func (ps *packageService) ListBoundPackages(ctx service.Context, applicationKey string, request *model.ListBoundPackagesRequest) iter.Seq2[model.PackageBinding, error] {
+	if request == nil {
+		request = &model.ListBoundPackagesRequest{}
+	}
+	return apphttp.Limit(ps.listMatchingPackages(ctx, applicationKey, request), request.MaxItems)
+}
+
+func (ps *packageService) listMatchingPackages(ctx service.Context, applicationKey string, request *model.ListBoundPackagesRequest) iter.Seq2[model.PackageBinding, error] {
+	return func(yield func(model.PackageBinding, error) bool) {
+		if _, err := path.Match(request.NamePattern, ""); err != nil {
+			yield(model.PackageBinding{}, errorutils.CheckErrorf("invalid package name pattern '%s': %s", request.NamePattern, err.Error()))
+			return
+		}
+
+		endpoint := fmt.Sprintf("/v1/applications/%s/packages", applicationKey)
+		options := apphttp.PageOptions{Operation: "failed to list bound packages"}
+		bindings := apphttp.Paginate(ctx.GetHttpClient(), endpoint, options, func(body []byte) (*apphttp.Page[model.PackageBinding], error) {
+			var page model.PackagesResponse
+			if err := json.Unmarshal(body, &page); err != nil {
+				return nil, errorutils.CheckErrorf("failed to parse the bound packages response: %s", err.Error())
+			}
+			return &apphttp.Page[model.PackageBinding]{Items: page.Packages, Total: page.Total, NextCursor: page.NextCursor}, nil
+		})
+		for binding, err := range bindings {
+			if err == nil && !matchesPackageFilters(binding, request) {
+				continue
+			}
+			if !yield(binding, err) {
+				return
+			}
+		}
+	}
+}
+
+func matchesPackageFilters(binding model.PackageBinding, request *model.ListBoundPackagesRequest) bool {
+	if request.Type != "" && !strings.EqualFold(binding.Type, request.Type) {
+		return false
+	}
+	if request.NamePattern != "" {
+		// The pattern was validated before listing, so the error can be ignored.
+		matched, _ := path.Match(request.NamePattern, binding.Name)
+		return matched
+	}
+	return true
+}
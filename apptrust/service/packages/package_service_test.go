@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"testing"
 
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	mockhttp "github.com/jfrog/jfrog-cli-application/apptrust/http/mocks"
 	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
 	"go.uber.org/mock/gomock"
@@ -73,7 +74,7 @@ func TestBindPackage(t *testing.T) {
 			mockCtx := mockservice.NewMockContext(ctrl)
 			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
 
-			err := service.BindPackage(mockCtx, applicationKey, tt.request)
+			_, err := service.BindPackage(mockCtx, applicationKey, tt.request)
 			if tt.expectedError == "" {
 				assert.NoError(t, err)
 			} else {
@@ -157,3 +158,163 @@ func TestUnbindPackage(t *testing.T) {
 		})
 	}
 }
+
+func TestListBoundPackages(t *testing.T) {
+	endpoint := "/v1/applications/test-app/packages"
+	firstPage := `{"packages":[{"type":"npm","name":"frontend-ui","num_versions":2,"latest_version":"1.1.0"},{"type":"docker","name":"frontend-img"}],"total":3}`
+	secondPage := `{"packages":[{"type":"npm","name":"backend-api","num_versions":1,"latest_version":"0.1.0"}],"total":3}`
+
+	tests := []struct {
+		name     string
+		request  *model.ListBoundPackagesRequest
+		expected []model.PackageBinding
+	}{
+		{
+			name:    "no filters",
+			request: nil,
+			expected: []model.PackageBinding{
+				{Type: "npm", Name: "frontend-ui", NumVersions: 2, LatestVersion: "1.1.0"},
+				{Type: "docker", Name: "frontend-img"},
+				{Type: "npm", Name: "backend-api", NumVersions: 1, LatestVersion: "0.1.0"},
+			},
+		},
+		{
+			name:    "filter by type",
+			request: &model.ListBoundPackagesRequest{Type: "NPM"},
+			expected: []model.PackageBinding{
+				{Type: "npm", Name: "frontend-ui", NumVersions: 2, LatestVersion: "1.1.0"},
+				{Type: "npm", Name: "backend-api", NumVersions: 1, LatestVersion: "0.1.0"},
+			},
+		},
+		{
+			name:    "filter by type and name pattern",
+			request: &model.ListBoundPackagesRequest{Type: "npm", NamePattern: "frontend-*"},
+			expected: []model.PackageBinding{
+				{Type: "npm", Name: "frontend-ui", NumVersions: 2, LatestVersion: "1.1.0"},
+			},
+		},
+		{
+			name:     "no matches",
+			request:  &model.ListBoundPackagesRequest{NamePattern: "missing-*"},
+			expected: []model.PackageBinding{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			gomock.InOrder(
+				mockHttpClient.EXPECT().Get(endpoint, map[string]string{"offset": "0", "limit": "100"}).
+					Return(&http.Response{StatusCode: http.StatusOK}, []byte(firstPage), nil),
+				mockHttpClient.EXPECT().Get(endpoint, map[string]string{"offset": "2", "limit": "100"}).
+					Return(&http.Response{StatusCode: http.StatusOK}, []byte(secondPage), nil),
+			)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+			bindings, err := apphttp.Collect(NewPackageService().ListBoundPackages(mockCtx, "test-app", tt.request))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, bindings)
+		})
+	}
+}
+
+func TestListBoundPackages_MaxItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoint := "/v1/applications/test-app/packages"
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	// Only the first page is requested, since it holds enough matching packages.
+	mockHttpClient.EXPECT().Get(endpoint, map[string]string{"offset": "0", "limit": "100"}).
+		Return(&http.Response{StatusCode: http.StatusOK},
+			[]byte(`{"packages":[{"type":"npm","name":"frontend-ui"},{"type":"docker","name":"frontend-img"},{"type":"npm","name":"backend-api"}],"total":300}`), nil).
+		Times(1)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+	bindings, err := apphttp.Collect(NewPackageService().ListBoundPackages(mockCtx, "test-app", &model.ListBoundPackagesRequest{Type: "npm", MaxItems: 2}))
+	assert.NoError(t, err)
+	assert.Equal(t, []model.PackageBinding{{Type: "npm", Name: "frontend-ui"}, {Type: "npm", Name: "backend-api"}}, bindings)
+}
+
+func TestListBoundPackages_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *model.ListBoundPackagesRequest
+		mockResponse  *http.Response
+		mockBody      string
+		mockError     error
+		expectedError string
+	}{
+		{
+			name:          "failed with non-200 status code",
+			mockResponse:  &http.Response{StatusCode: http.StatusNotFound},
+			mockBody:      "not found",
+			expectedError: "failed to list bound packages. Status code: 404.\nnot found",
+		},
+		{
+			name:          "invalid response body",
+			mockResponse:  &http.Response{StatusCode: http.StatusOK},
+			mockBody:      "not-json",
+			expectedError: "failed to parse the bound packages response",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/packages", gomock.Any()).
+				Return(tt.mockResponse, []byte(tt.mockBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			bindings, err := apphttp.Collect(NewPackageService().ListBoundPackages(mockCtx, "test-app", tt.request))
+			assert.ErrorContains(t, err, tt.expectedError)
+			assert.Nil(t, bindings)
+		})
+	}
+}
+
+func TestListBoundPackages_InvalidNamePattern(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+
+	bindings, err := apphttp.Collect(NewPackageService().ListBoundPackages(mockCtx, "test-app", &model.ListBoundPackagesRequest{NamePattern: "[a-"}))
+	assert.ErrorContains(t, err, "invalid package name pattern")
+	assert.Nil(t, bindings)
+}
+
+func TestBindPackage_Response(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &model.BindPackageRequest{Type: "npm", Name: "test-package", Version: "1.0.0"}
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Post("/v1/applications/test-app/packages", request, nil).
+		Return(&http.Response{StatusCode: http.StatusCreated},
+			[]byte(`{"application_key":"test-app","package_type":"npm","package_name":"test-package","package_version":"1.0.0"}`), nil).Times(1)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+	binding, err := NewPackageService().BindPackage(mockCtx, "test-app", request)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.BindPackageResponse{ApplicationKey: "test-app", Type: "npm", Name: "test-package", Version: "1.0.0"}, binding)
+}
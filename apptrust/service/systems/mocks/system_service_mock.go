@@ -12,6 +12,7 @@ package mock_systems
 import (
 	reflect "reflect"
 
+	model "github.com/jfrog/jfrog-cli-application/apptrust/model"
 	service "github.com/jfrog/jfrog-cli-application/apptrust/service"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -40,12 +41,28 @@ func (m *MockSystemService) EXPECT() *MockSystemServiceMockRecorder {
 	return m.recorder
 }
 
+// GetVersion mocks base method.
+func (m *MockSystemService) GetVersion(ctx service.Context) (*model.SystemVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx)
+	ret0, _ := ret[0].(*model.SystemVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockSystemServiceMockRecorder) GetVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockSystemService)(nil).GetVersion), ctx)
+}
+
 // Ping mocks base method.
-func (m *MockSystemService) Ping(ctx service.Context) error {
+func (m *MockSystemService) Ping(ctx service.Context) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Ping", ctx)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Ping indicates an expected call of Ping.
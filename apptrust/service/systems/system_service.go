@@ -3,14 +3,20 @@ package systems
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
-	"fmt"
+	"encoding/json"
+	"net/http"
 
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
-	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 type SystemService interface {
-	Ping(ctx service.Context) error
+	// Ping checks that the AppTrust service is reachable, and returns its response, e.g., "OK".
+	Ping(ctx service.Context) (string, error)
+	// GetVersion returns the version of the AppTrust service.
+	GetVersion(ctx service.Context) (*model.SystemVersion, error)
 }
 
 type systemService struct{}
@@ -19,16 +25,32 @@ func NewSystemService() SystemService {
 	return &systemService{}
 }
 
-func (ss *systemService) Ping(ctx service.Context) error {
+func (ss *systemService) Ping(ctx service.Context) (string, error) {
 	response, body, err := ctx.GetHttpClient().Get("/v1/system/ping", nil)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if response.StatusCode != 200 {
-		return fmt.Errorf("failed pinging application service. Status code: %d", response.StatusCode)
+		return "", apphttp.NewApptrustError("failed pinging application service", response, body)
 	}
 
-	log.Output(string(body))
-	return nil
+	return string(body), nil
+}
+
+func (ss *systemService) GetVersion(ctx service.Context) (*model.SystemVersion, error) {
+	response, body, err := ctx.GetHttpClient().Get("/v1/system/version", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckError(apphttp.NewApptrustError("failed to get the AppTrust version", response, body))
+	}
+
+	version := new(model.SystemVersion)
+	if err = json.Unmarshal(body, version); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the version response: %s", err.Error())
+	}
+	return version, nil
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
 	"go.uber.org/mock/gomock"
 
@@ -19,6 +20,7 @@ func TestSystemService_Ping(t *testing.T) {
 		mockBody      []byte
 		mockError     error
 		expectedError error
+		expectedBody  string
 	}{
 		{
 			name: "Ping successful",
@@ -28,6 +30,7 @@ func TestSystemService_Ping(t *testing.T) {
 			mockBody:      []byte("pong"),
 			mockError:     nil,
 			expectedError: nil,
+			expectedBody:  "pong",
 		},
 		{
 			name: "Ping failed with non-200 status code",
@@ -36,7 +39,7 @@ func TestSystemService_Ping(t *testing.T) {
 			},
 			mockBody:      []byte(""),
 			mockError:     nil,
-			expectedError: errors.New("failed pinging application service. Status code: 500"),
+			expectedError: errors.New("failed pinging application service. Status code: 500."),
 		},
 		{
 			name:          "Ping failed with error",
@@ -60,13 +63,71 @@ func TestSystemService_Ping(t *testing.T) {
 			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
 
 			ss := NewSystemService()
-			err := ss.Ping(mockCtx)
+			body, err := ss.Ping(mockCtx)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, body)
 			}
 		})
 	}
 }
+
+func TestSystemService_GetVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockResponse    *http.Response
+		mockBody        []byte
+		mockError       error
+		expectedError   string
+		expectedVersion *model.SystemVersion
+	}{
+		{
+			name:            "success",
+			mockResponse:    &http.Response{StatusCode: http.StatusOK},
+			mockBody:        []byte(`{"version":"1.2.3","revision":"abc"}`),
+			expectedVersion: &model.SystemVersion{Version: "1.2.3", Revision: "abc"},
+		},
+		{
+			name:          "not found",
+			mockResponse:  &http.Response{StatusCode: http.StatusNotFound},
+			mockBody:      []byte(""),
+			expectedError: "failed to get the AppTrust version. Status code: 404.",
+		},
+		{
+			name:          "invalid response",
+			mockResponse:  &http.Response{StatusCode: http.StatusOK},
+			mockBody:      []byte("not json"),
+			expectedError: "failed to parse the version response",
+		},
+		{
+			name:          "http error",
+			mockError:     errors.New("http error"),
+			expectedError: "http error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/system/version", nil).
+				Return(tt.mockResponse, tt.mockBody, tt.mockError)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			version, err := NewSystemService().GetVersion(mockCtx)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedVersion, version)
+		})
+	}
+}
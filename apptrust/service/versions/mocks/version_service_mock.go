@@ -10,6 +10,7 @@
 package mock_versions
 
 import (
+	iter "iter"
 	reflect "reflect"
 
 	model "github.com/jfrog/jfrog-cli-application/apptrust/model"
@@ -42,11 +43,12 @@ func (m *MockVersionService) EXPECT() *MockVersionServiceMockRecorder {
 }
 
 // CreateAppVersion mocks base method.
-func (m *MockVersionService) CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync, dryRun bool) error {
+func (m *MockVersionService) CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync, dryRun bool) (*model.AppVersionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateAppVersion", ctx, request, sync, dryRun)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.AppVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateAppVersion indicates an expected call of CreateAppVersion.
@@ -69,12 +71,72 @@ func (mr *MockVersionServiceMockRecorder) DeleteAppVersion(ctx, applicationKey,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppVersion", reflect.TypeOf((*MockVersionService)(nil).DeleteAppVersion), ctx, applicationKey, version)
 }
 
+// DiffAppVersions mocks base method.
+func (m *MockVersionService) DiffAppVersions(ctx service.Context, base, target model.VersionRef) (*model.VersionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffAppVersions", ctx, base, target)
+	ret0, _ := ret[0].(*model.VersionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffAppVersions indicates an expected call of DiffAppVersions.
+func (mr *MockVersionServiceMockRecorder) DiffAppVersions(ctx, base, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffAppVersions", reflect.TypeOf((*MockVersionService)(nil).DiffAppVersions), ctx, base, target)
+}
+
+// GetAppVersion mocks base method.
+func (m *MockVersionService) GetAppVersion(ctx service.Context, applicationKey, version string, includeArtifacts bool) (*model.VersionContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppVersion", ctx, applicationKey, version, includeArtifacts)
+	ret0, _ := ret[0].(*model.VersionContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppVersion indicates an expected call of GetAppVersion.
+func (mr *MockVersionServiceMockRecorder) GetAppVersion(ctx, applicationKey, version, includeArtifacts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppVersion", reflect.TypeOf((*MockVersionService)(nil).GetAppVersion), ctx, applicationKey, version, includeArtifacts)
+}
+
+// GetAppVersionHistory mocks base method.
+func (m *MockVersionService) GetAppVersionHistory(ctx service.Context, applicationKey, version string) ([]model.VersionHistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppVersionHistory", ctx, applicationKey, version)
+	ret0, _ := ret[0].([]model.VersionHistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppVersionHistory indicates an expected call of GetAppVersionHistory.
+func (mr *MockVersionServiceMockRecorder) GetAppVersionHistory(ctx, applicationKey, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppVersionHistory", reflect.TypeOf((*MockVersionService)(nil).GetAppVersionHistory), ctx, applicationKey, version)
+}
+
+// ListAppVersions mocks base method.
+func (m *MockVersionService) ListAppVersions(ctx service.Context, applicationKey string, request *model.ListAppVersionsRequest) iter.Seq2[model.AppVersion, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppVersions", ctx, applicationKey, request)
+	ret0, _ := ret[0].(iter.Seq2[model.AppVersion, error])
+	return ret0
+}
+
+// ListAppVersions indicates an expected call of ListAppVersions.
+func (mr *MockVersionServiceMockRecorder) ListAppVersions(ctx, applicationKey, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppVersions", reflect.TypeOf((*MockVersionService)(nil).ListAppVersions), ctx, applicationKey, request)
+}
+
 // PromoteAppVersion mocks base method.
-func (m *MockVersionService) PromoteAppVersion(ctx service.Context, applicationKey, version string, payload *model.PromoteAppVersionRequest, sync bool) error {
+func (m *MockVersionService) PromoteAppVersion(ctx service.Context, applicationKey, version string, payload *model.PromoteAppVersionRequest, sync bool) (*model.PromoteAppVersionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PromoteAppVersion", ctx, applicationKey, version, payload, sync)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.PromoteAppVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // PromoteAppVersion indicates an expected call of PromoteAppVersion.
@@ -84,11 +146,12 @@ func (mr *MockVersionServiceMockRecorder) PromoteAppVersion(ctx, applicationKey,
 }
 
 // ReleaseAppVersion mocks base method.
-func (m *MockVersionService) ReleaseAppVersion(ctx service.Context, applicationKey, version string, request *model.ReleaseAppVersionRequest, sync bool) error {
+func (m *MockVersionService) ReleaseAppVersion(ctx service.Context, applicationKey, version string, request *model.ReleaseAppVersionRequest, sync bool) (*model.PromoteAppVersionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReleaseAppVersion", ctx, applicationKey, version, request, sync)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.PromoteAppVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ReleaseAppVersion indicates an expected call of ReleaseAppVersion.
@@ -98,11 +161,12 @@ func (mr *MockVersionServiceMockRecorder) ReleaseAppVersion(ctx, applicationKey,
 }
 
 // RollbackAppVersion mocks base method.
-func (m *MockVersionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) error {
+func (m *MockVersionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) (*model.RollbackAppVersionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RollbackAppVersion", ctx, applicationKey, version, request, sync)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.RollbackAppVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RollbackAppVersion indicates an expected call of RollbackAppVersion.
@@ -126,11 +190,12 @@ func (mr *MockVersionServiceMockRecorder) UpdateAppVersion(ctx, applicationKey,
 }
 
 // UpdateAppVersionSources mocks base method.
-func (m *MockVersionService) UpdateAppVersionSources(ctx service.Context, applicationKey, version string, request *model.UpdateVersionSourcesRequest, sync, dryRun, failFast bool) error {
+func (m *MockVersionService) UpdateAppVersionSources(ctx service.Context, applicationKey, version string, request *model.UpdateVersionSourcesRequest, sync, dryRun, failFast bool) (*model.AppVersionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateAppVersionSources", ctx, applicationKey, version, request, sync, dryRun, failFast)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.AppVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateAppVersionSources indicates an expected call of UpdateAppVersionSources.
@@ -138,3 +203,18 @@ func (mr *MockVersionServiceMockRecorder) UpdateAppVersionSources(ctx, applicati
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAppVersionSources", reflect.TypeOf((*MockVersionService)(nil).UpdateAppVersionSources), ctx, applicationKey, version, request, sync, dryRun, failFast)
 }
+
+// WaitForAppVersion mocks base method.
+func (m *MockVersionService) WaitForAppVersion(ctx service.Context, applicationKey, version string, request *model.WaitAppVersionRequest) (*model.VersionContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForAppVersion", ctx, applicationKey, version, request)
+	ret0, _ := ret[0].(*model.VersionContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForAppVersion indicates an expected call of WaitForAppVersion.
+func (mr *MockVersionServiceMockRecorder) WaitForAppVersion(ctx, applicationKey, version, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForAppVersion", reflect.TypeOf((*MockVersionService)(nil).WaitForAppVersion), ctx, applicationKey, version, request)
+}
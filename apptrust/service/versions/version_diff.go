@@ -0,0 +1,160 @@
+package versions
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+)
+
+// DiffAppVersions compares the resolved contents of two application versions.
+// The versions may belong to different applications.
+func (vs *versionService) DiffAppVersions(ctx service.Context, base, target model.VersionRef) (*model.VersionDiff, error) {
+	baseContent, err := vs.GetAppVersion(ctx, base.ApplicationKey, base.Version, true)
+	if err != nil {
+		return nil, err
+	}
+	targetContent, err := vs.GetAppVersion(ctx, target.ApplicationKey, target.Version, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.VersionDiff{
+		Base:      base,
+		Target:    target,
+		Packages:  diffPackages(baseContent.Releasables, targetContent.Releasables),
+		Artifacts: diffArtifacts(baseContent.Releasables, targetContent.Releasables),
+	}, nil
+}
+
+// diffPackages compares the packages of the versions. The versions of a package that both hold are compared by sha256.
+// When a single other version of the package is left on each side, e.g., after a version bump, the package is changed,
+// and the other versions are added or removed.
+func diffPackages(base, target []model.Releasable) []model.PackageDiff {
+	packageKey := func(releasable model.Releasable) string {
+		return releasable.PackageType + "/" + releasable.Name
+	}
+	baseByKey := map[string][]model.Releasable{}
+	for _, releasable := range base {
+		baseByKey[packageKey(releasable)] = append(baseByKey[packageKey(releasable)], releasable)
+	}
+	targetByKey := map[string][]model.Releasable{}
+	for _, releasable := range target {
+		targetByKey[packageKey(releasable)] = append(targetByKey[packageKey(releasable)], releasable)
+	}
+
+	diffs := []model.PackageDiff{}
+	for key, targetReleasables := range targetByKey {
+		diffs = append(diffs, diffPackageVersions(baseByKey[key], targetReleasables)...)
+	}
+	for key, baseReleasables := range baseByKey {
+		if _, found := targetByKey[key]; !found {
+			diffs = append(diffs, diffPackageVersions(baseReleasables, nil)...)
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].PackageType != diffs[j].PackageType {
+			return diffs[i].PackageType < diffs[j].PackageType
+		}
+		if diffs[i].Name != diffs[j].Name {
+			return diffs[i].Name < diffs[j].Name
+		}
+		return cmp.Or(diffs[i].BaseVersion, diffs[i].TargetVersion) < cmp.Or(diffs[j].BaseVersion, diffs[j].TargetVersion)
+	})
+	return diffs
+}
+
+// diffPackageVersions compares the versions of a single package in the base and target versions.
+func diffPackageVersions(base, target []model.Releasable) []model.PackageDiff {
+	var diffs []model.PackageDiff
+	var removed, added []model.Releasable
+	matched := make([]bool, len(target))
+	for _, baseReleasable := range base {
+		i := slices.IndexFunc(target, func(targetReleasable model.Releasable) bool {
+			return targetReleasable.Version == baseReleasable.Version
+		})
+		if i < 0 || matched[i] {
+			removed = append(removed, baseReleasable)
+			continue
+		}
+		matched[i] = true
+		if baseReleasable.SHA256 != target[i].SHA256 {
+			diffs = append(diffs, newPackageDiff(model.DiffChangeChanged, baseReleasable, target[i]))
+		}
+	}
+	for i, targetReleasable := range target {
+		if !matched[i] {
+			added = append(added, targetReleasable)
+		}
+	}
+
+	if len(removed) == 1 && len(added) == 1 {
+		return append(diffs, newPackageDiff(model.DiffChangeChanged, removed[0], added[0]))
+	}
+	for _, baseReleasable := range removed {
+		diffs = append(diffs, newPackageDiff(model.DiffChangeRemoved, baseReleasable, model.Releasable{}))
+	}
+	for _, targetReleasable := range added {
+		diffs = append(diffs, newPackageDiff(model.DiffChangeAdded, model.Releasable{}, targetReleasable))
+	}
+	return diffs
+}
+
+// newPackageDiff returns the diff of a package, where base or target is empty for an added or removed package.
+func newPackageDiff(change string, base, target model.Releasable) model.PackageDiff {
+	return model.PackageDiff{
+		Change:        change,
+		PackageType:   cmp.Or(target.PackageType, base.PackageType),
+		Name:          cmp.Or(target.Name, base.Name),
+		BaseVersion:   base.Version,
+		TargetVersion: target.Version,
+		BaseSHA256:    base.SHA256,
+		TargetSHA256:  target.SHA256,
+	}
+}
+
+func diffArtifacts(base, target []model.Releasable) []model.ArtifactDiff {
+	baseArtifacts := artifactChecksumsByPath(base)
+	targetArtifacts := artifactChecksumsByPath(target)
+
+	diffs := []model.ArtifactDiff{}
+	for path, targetSHA256 := range targetArtifacts {
+		baseSHA256, found := baseArtifacts[path]
+		switch {
+		case !found:
+			diffs = append(diffs, model.ArtifactDiff{Change: model.DiffChangeAdded, Path: path, TargetSHA256: targetSHA256})
+		case baseSHA256 != targetSHA256:
+			diffs = append(diffs, model.ArtifactDiff{Change: model.DiffChangeChanged, Path: path, BaseSHA256: baseSHA256, TargetSHA256: targetSHA256})
+		}
+	}
+	for path, baseSHA256 := range baseArtifacts {
+		if _, found := targetArtifacts[path]; !found {
+			diffs = append(diffs, model.ArtifactDiff{Change: model.DiffChangeRemoved, Path: path, BaseSHA256: baseSHA256})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+	return diffs
+}
+
+// artifactChecksumsByPath returns the checksums of the artifacts by their path in their repository, so that the artifacts
+// of versions that were promoted to different repositories match.
+func artifactChecksumsByPath(releasables []model.Releasable) map[string]string {
+	checksums := make(map[string]string)
+	for _, releasable := range releasables {
+		for _, artifact := range releasable.Artifacts {
+			path := artifact.Path
+			if releasable.RepositoryKey != "" {
+				path = strings.TrimPrefix(path, releasable.RepositoryKey+"/")
+			}
+			checksums[path] = artifact.SHA256
+		}
+	}
+	return checksums
+}
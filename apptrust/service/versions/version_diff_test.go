@@ -0,0 +1,131 @@
+package versions
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	mockhttp "github.com/jfrog/jfrog-cli-application/apptrust/http/mocks"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffAppVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	include := map[string]string{"include": "releasables_expanded"}
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Get("/v1/applications/app-a/versions/1.0.0/content", include).
+		Return(&http.Response{StatusCode: http.StatusOK}, []byte(`{"releasables":[
+			{"name":"lib","version":"1.0.0","package_type":"npm","repository_key":"npm-dev","sha256":"a1","artifacts":[{"path":"npm-dev/lib/-/lib-1.0.0.tgz","sha256":"a1"}]},
+			{"name":"same","version":"2.0.0","package_type":"npm","repository_key":"npm-dev","sha256":"s1","artifacts":[{"path":"npm-dev/same/-/same-2.0.0.tgz","sha256":"s1"}]},
+			{"name":"old","version":"0.1.0","package_type":"maven","repository_key":"maven-dev","sha256":"o1","artifacts":[{"path":"maven-dev/old/0.1.0/old.jar","sha256":"o1"}]}
+		]}`), nil).Times(1)
+	mockHttpClient.EXPECT().Get("/v1/applications/app-b/versions/2.0.0/content", include).
+		Return(&http.Response{StatusCode: http.StatusOK}, []byte(`{"releasables":[
+			{"name":"lib","version":"1.1.0","package_type":"npm","repository_key":"npm-qa","sha256":"a2","artifacts":[{"path":"npm-qa/lib/-/lib-1.1.0.tgz","sha256":"a2"}]},
+			{"name":"same","version":"2.0.0","package_type":"npm","repository_key":"npm-qa","sha256":"s1","artifacts":[{"path":"npm-qa/same/-/same-2.0.0.tgz","sha256":"s1"}]},
+			{"name":"image","version":"3.0.0","package_type":"docker","repository_key":"docker-qa","sha256":"d1","artifacts":[{"path":"docker-qa/image/3.0.0/manifest.json","sha256":"d1"}]}
+		]}`), nil).Times(1)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+	base := model.VersionRef{ApplicationKey: "app-a", Version: "1.0.0"}
+	target := model.VersionRef{ApplicationKey: "app-b", Version: "2.0.0"}
+	diff, err := NewVersionService().DiffAppVersions(mockCtx, base, target)
+	assert.NoError(t, err)
+	assert.True(t, diff.HasDifferences())
+	assert.Equal(t, &model.VersionDiff{
+		Base:   base,
+		Target: target,
+		Packages: []model.PackageDiff{
+			{Change: model.DiffChangeAdded, PackageType: "docker", Name: "image", TargetVersion: "3.0.0", TargetSHA256: "d1"},
+			{Change: model.DiffChangeRemoved, PackageType: "maven", Name: "old", BaseVersion: "0.1.0", BaseSHA256: "o1"},
+			{Change: model.DiffChangeChanged, PackageType: "npm", Name: "lib", BaseVersion: "1.0.0", TargetVersion: "1.1.0", BaseSHA256: "a1", TargetSHA256: "a2"},
+		},
+		// The artifacts are matched by their path in their repository, which changed with the promotion.
+		Artifacts: []model.ArtifactDiff{
+			{Change: model.DiffChangeAdded, Path: "image/3.0.0/manifest.json", TargetSHA256: "d1"},
+			{Change: model.DiffChangeRemoved, Path: "lib/-/lib-1.0.0.tgz", BaseSHA256: "a1"},
+			{Change: model.DiffChangeAdded, Path: "lib/-/lib-1.1.0.tgz", TargetSHA256: "a2"},
+			{Change: model.DiffChangeRemoved, Path: "old/0.1.0/old.jar", BaseSHA256: "o1"},
+		},
+	}, diff)
+}
+
+func TestDiffAppVersions_Identical(t *testing.T) {
+	releasables := []model.Releasable{
+		{Name: "lib", Version: "1.0.0", PackageType: "npm", SHA256: "a1", Artifacts: []model.ReleasableArtifact{{Path: "npm/lib/-/lib-1.0.0.tgz", SHA256: "a1"}}},
+	}
+	assert.Empty(t, diffPackages(releasables, releasables))
+	assert.Empty(t, diffArtifacts(releasables, releasables))
+}
+
+func TestDiffArtifacts_ChangedChecksum(t *testing.T) {
+	base := []model.Releasable{{Artifacts: []model.ReleasableArtifact{{Path: "generic/file.bin", SHA256: "old"}}}}
+	target := []model.Releasable{{Artifacts: []model.ReleasableArtifact{{Path: "generic/file.bin", SHA256: "new"}}}}
+	assert.Equal(t, []model.ArtifactDiff{
+		{Change: model.DiffChangeChanged, Path: "generic/file.bin", BaseSHA256: "old", TargetSHA256: "new"},
+	}, diffArtifacts(base, target))
+}
+
+func TestDiffPackages_MultipleVersions(t *testing.T) {
+	base := []model.Releasable{
+		{Name: "lib", Version: "1.0.0", PackageType: "npm", SHA256: "a1"},
+		{Name: "lib", Version: "2.0.0", PackageType: "npm", SHA256: "b1"},
+		{Name: "util", Version: "1.0.0", PackageType: "npm", SHA256: "u1"},
+		{Name: "util", Version: "2.0.0", PackageType: "npm", SHA256: "v1"},
+	}
+	target := []model.Releasable{
+		{Name: "lib", Version: "2.0.0", PackageType: "npm", SHA256: "b2"},
+		{Name: "lib", Version: "1.0.0", PackageType: "npm", SHA256: "a1"},
+		{Name: "util", Version: "3.0.0", PackageType: "npm", SHA256: "w1"},
+		{Name: "util", Version: "4.0.0", PackageType: "npm", SHA256: "x1"},
+	}
+
+	// The versions of a package don't overwrite each other, and a package with more than one other version
+	// on each side is reported as removed and added.
+	assert.Equal(t, []model.PackageDiff{
+		{Change: model.DiffChangeChanged, PackageType: "npm", Name: "lib", BaseVersion: "2.0.0", TargetVersion: "2.0.0", BaseSHA256: "b1", TargetSHA256: "b2"},
+		{Change: model.DiffChangeRemoved, PackageType: "npm", Name: "util", BaseVersion: "1.0.0", BaseSHA256: "u1"},
+		{Change: model.DiffChangeRemoved, PackageType: "npm", Name: "util", BaseVersion: "2.0.0", BaseSHA256: "v1"},
+		{Change: model.DiffChangeAdded, PackageType: "npm", Name: "util", TargetVersion: "3.0.0", TargetSHA256: "w1"},
+		{Change: model.DiffChangeAdded, PackageType: "npm", Name: "util", TargetVersion: "4.0.0", TargetSHA256: "x1"},
+	}, diffPackages(base, target))
+}
+
+func TestDiffArtifacts_DifferentRepositories(t *testing.T) {
+	base := []model.Releasable{{RepositoryKey: "generic-dev", Artifacts: []model.ReleasableArtifact{
+		{Path: "generic-dev/file.bin", SHA256: "same"},
+		{Path: "generic-dev/other.bin", SHA256: "old"},
+	}}}
+	target := []model.Releasable{{RepositoryKey: "generic-prod", Artifacts: []model.ReleasableArtifact{
+		{Path: "generic-prod/file.bin", SHA256: "same"},
+		{Path: "generic-prod/other.bin", SHA256: "new"},
+	}}}
+	assert.Equal(t, []model.ArtifactDiff{
+		{Change: model.DiffChangeChanged, Path: "other.bin", BaseSHA256: "old", TargetSHA256: "new"},
+	}, diffArtifacts(base, target))
+}
+
+func TestDiffAppVersions_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Get("/v1/applications/app-a/versions/1.0.0/content", gomock.Any()).
+		Return(nil, nil, errors.New("http client error")).Times(1)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+	diff, err := NewVersionService().DiffAppVersions(mockCtx,
+		model.VersionRef{ApplicationKey: "app-a", Version: "1.0.0"},
+		model.VersionRef{ApplicationKey: "app-a", Version: "2.0.0"})
+	assert.EqualError(t, err, "http client error")
+	assert.Nil(t, diff)
+}
@@ -0,0 +1,34 @@
+package versions
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// GetAppVersionHistory returns the promotion, release and rollback events of an application version,
+// ordered from the oldest to the newest.
+func (vs *versionService) GetAppVersionHistory(ctx service.Context, applicationKey string, version string) ([]model.VersionHistoryEvent, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/history", applicationKey, version)
+	options := apphttp.PageOptions{Operation: "failed to get app version history"}
+	events, err := apphttp.Collect(apphttp.Paginate(ctx.GetHttpClient(), endpoint, options, func(body []byte) (*apphttp.Page[model.VersionHistoryEvent], error) {
+		var page model.VersionHistoryResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the app version history response: %s", err.Error())
+		}
+		return &apphttp.Page[model.VersionHistoryEvent]{Items: page.Events, Total: page.Total, NextCursor: page.NextCursor}, nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return compareCreationTimes(events[i].Created, events[j].Created) < 0
+	})
+	return events, nil
+}
@@ -0,0 +1,87 @@
+package versions
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	mockhttp "github.com/jfrog/jfrog-cli-application/apptrust/http/mocks"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAppVersionHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoint := "/v1/applications/test-app/versions/1.0.0/history"
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	gomock.InOrder(
+		mockHttpClient.EXPECT().Get(endpoint, map[string]string{"offset": "0", "limit": "100"}).
+			Return(&http.Response{StatusCode: http.StatusOK},
+				[]byte(`{"events":[{"event_type":"rollback","source_stage":"QA","target_stage":"DEV","status":"COMPLETED","created":"2025-01-03T10:00:00Z"},{"event_type":"promotion","source_stage":"DEV","target_stage":"QA","promotion_type":"copy","status":"COMPLETED","created_by":"admin","created":"2025-01-02T10:00:00Z"}],"total":3}`), nil),
+		mockHttpClient.EXPECT().Get(endpoint, map[string]string{"offset": "2", "limit": "100"}).
+			Return(&http.Response{StatusCode: http.StatusOK},
+				[]byte(`{"events":[{"event_type":"promotion","target_stage":"DEV","promotion_type":"move","status":"FAILED","messages":["repository not found"],"created":"2025-01-01T10:00:00Z"}],"total":3}`), nil),
+	)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+	events, err := NewVersionService().GetAppVersionHistory(mockCtx, "test-app", "1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, []model.VersionHistoryEvent{
+		{EventType: model.VersionEventPromotion, TargetStage: "DEV", PromotionType: "move", Status: "FAILED", Messages: []string{"repository not found"}, Created: "2025-01-01T10:00:00Z"},
+		{EventType: model.VersionEventPromotion, SourceStage: "DEV", TargetStage: "QA", PromotionType: "copy", Status: "COMPLETED", CreatedBy: "admin", Created: "2025-01-02T10:00:00Z"},
+		{EventType: model.VersionEventRollback, SourceStage: "QA", TargetStage: "DEV", Status: "COMPLETED", Created: "2025-01-03T10:00:00Z"},
+	}, events)
+}
+
+func TestGetAppVersionHistory_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  *http.Response
+		mockBody      []byte
+		mockError     error
+		expectedError string
+	}{
+		{
+			name:          "failure status code",
+			mockResponse:  &http.Response{StatusCode: http.StatusNotFound},
+			mockBody:      []byte("not found"),
+			expectedError: "failed to get app version history. Status code: 404.\nnot found",
+		},
+		{
+			name:          "invalid response body",
+			mockResponse:  &http.Response{StatusCode: http.StatusOK},
+			mockBody:      []byte("not-json"),
+			expectedError: "failed to parse the app version history response",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/history", gomock.Any()).
+				Return(tt.mockResponse, tt.mockBody, tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			events, err := NewVersionService().GetAppVersionHistory(mockCtx, "test-app", "1.0.0")
+			assert.ErrorContains(t, err, tt.expectedError)
+			assert.Nil(t, events)
+		})
+	}
+}
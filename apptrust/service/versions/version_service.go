@@ -3,25 +3,35 @@ package versions
 //go:generate ${PROJECT_DIR}/scripts/mockgen.sh ${GOFILE}
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"strconv"
+	"time"
 
 	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
-	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 )
 
 type VersionService interface {
-	CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync, dryRun bool) error
-	PromoteAppVersion(ctx service.Context, applicationKey string, version string, payload *model.PromoteAppVersionRequest, sync bool) error
-	ReleaseAppVersion(ctx service.Context, applicationKey string, version string, request *model.ReleaseAppVersionRequest, sync bool) error
-	RollbackAppVersion(ctx service.Context, applicationKey string, version string, request *model.RollbackAppVersionRequest, sync bool) error
+	CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync, dryRun bool) (*model.AppVersionResponse, error)
+	PromoteAppVersion(ctx service.Context, applicationKey string, version string, payload *model.PromoteAppVersionRequest, sync bool) (*model.PromoteAppVersionResponse, error)
+	ReleaseAppVersion(ctx service.Context, applicationKey string, version string, request *model.ReleaseAppVersionRequest, sync bool) (*model.PromoteAppVersionResponse, error)
+	RollbackAppVersion(ctx service.Context, applicationKey string, version string, request *model.RollbackAppVersionRequest, sync bool) (*model.RollbackAppVersionResponse, error)
 	DeleteAppVersion(ctx service.Context, applicationKey string, version string) error
 	UpdateAppVersion(ctx service.Context, applicationKey string, version string, request *model.UpdateAppVersionRequest) error
-	UpdateAppVersionSources(ctx service.Context, applicationKey string, version string, request *model.UpdateVersionSourcesRequest, sync bool, dryRun bool, failFast bool) error
+	UpdateAppVersionSources(ctx service.Context, applicationKey string, version string, request *model.UpdateVersionSourcesRequest, sync bool, dryRun bool, failFast bool) (*model.AppVersionResponse, error)
+	ListAppVersions(ctx service.Context, applicationKey string, request *model.ListAppVersionsRequest) iter.Seq2[model.AppVersion, error]
+	GetAppVersion(ctx service.Context, applicationKey string, version string, includeArtifacts bool) (*model.VersionContentResponse, error)
+	WaitForAppVersion(ctx service.Context, applicationKey string, version string, request *model.WaitAppVersionRequest) (*model.VersionContentResponse, error)
+	GetAppVersionHistory(ctx service.Context, applicationKey string, version string) ([]model.VersionHistoryEvent, error)
+	DiffAppVersions(ctx service.Context, base, target model.VersionRef) (*model.VersionDiff, error)
 }
 
 type versionService struct{}
@@ -30,70 +40,63 @@ func NewVersionService() VersionService {
 	return &versionService{}
 }
 
-func (vs *versionService) CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync, dryRun bool) error {
+func (vs *versionService) CreateAppVersion(ctx service.Context, request *model.CreateAppVersionRequest, sync, dryRun bool) (*model.AppVersionResponse, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s/versions/", request.ApplicationKey)
 	response, responseBody, err := ctx.GetHttpClient().Post(endpoint, request,
 		map[string]string{"async": strconv.FormatBool(!sync), "dry_run": strconv.FormatBool(dryRun)})
 	if err != nil {
-		return err
+		return nil, interruptedError(err, request.ApplicationKey, request.Version)
 	}
 
 	if !apphttp.IsSuccessStatusCode(response.StatusCode) {
-		return fmt.Errorf("failed to create app version. Status code: %d. \n%s",
-			response.StatusCode, responseBody)
+		return nil, apphttp.NewApptrustError("failed to create app version", response, responseBody)
 	}
 
-	logSuccessMessage(sync, request, dryRun)
-	log.Output(string(responseBody))
-	return nil
+	return apphttp.DecodeResponse("create app version", responseBody,
+		&model.AppVersionResponse{ApplicationKey: request.ApplicationKey, Version: request.Version, Tag: request.Tag})
 }
 
-func (vs *versionService) PromoteAppVersion(ctx service.Context, applicationKey, version string, request *model.PromoteAppVersionRequest, sync bool) error {
+func (vs *versionService) PromoteAppVersion(ctx service.Context, applicationKey, version string, request *model.PromoteAppVersionRequest, sync bool) (*model.PromoteAppVersionResponse, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/promote", applicationKey, version)
 	response, responseBody, err := ctx.GetHttpClient().Post(endpoint, request, map[string]string{"async": strconv.FormatBool(!sync)})
 	if err != nil {
-		return err
+		return nil, interruptedError(err, applicationKey, version)
 	}
 
 	if !apphttp.IsSuccessStatusCode(response.StatusCode) {
-		return fmt.Errorf("failed to promote app version. Status code: %d. \n%s",
-			response.StatusCode, responseBody)
+		return nil, apphttp.NewApptrustError("failed to promote app version", response, responseBody)
 	}
 
-	log.Output(string(responseBody))
-	return nil
+	return apphttp.DecodeResponse("promote app version", responseBody, newPromoteAppVersionResponse(applicationKey, version, request.Stage, request.CommonPromoteAppVersion))
 }
 
-func (vs *versionService) ReleaseAppVersion(ctx service.Context, applicationKey, version string, request *model.ReleaseAppVersionRequest, sync bool) error {
+func (vs *versionService) ReleaseAppVersion(ctx service.Context, applicationKey, version string, request *model.ReleaseAppVersionRequest, sync bool) (*model.PromoteAppVersionResponse, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/release", applicationKey, version)
 	response, responseBody, err := ctx.GetHttpClient().Post(endpoint, request, map[string]string{"async": strconv.FormatBool(!sync)})
 	if err != nil {
-		return err
+		return nil, interruptedError(err, applicationKey, version)
 	}
 
 	if !apphttp.IsSuccessStatusCode(response.StatusCode) {
-		return fmt.Errorf("failed to release app version. Status code: %d. \n%s",
-			response.StatusCode, responseBody)
+		return nil, apphttp.NewApptrustError("failed to release app version", response, responseBody)
 	}
 
-	log.Output(string(responseBody))
-	return nil
+	return apphttp.DecodeResponse("release app version", responseBody, newPromoteAppVersionResponse(applicationKey, version, "", request.CommonPromoteAppVersion))
 }
 
-func (vs *versionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) error {
+func (vs *versionService) RollbackAppVersion(ctx service.Context, applicationKey, version string, request *model.RollbackAppVersionRequest, sync bool) (*model.RollbackAppVersionResponse, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/rollback", applicationKey, version)
 	response, responseBody, err := ctx.GetHttpClient().Post(endpoint, request, map[string]string{"async": strconv.FormatBool(!sync)})
 	if err != nil {
-		return err
+		return nil, interruptedError(err, applicationKey, version)
 	}
 
 	if !apphttp.IsSuccessStatusCode(response.StatusCode) {
-		return fmt.Errorf("failed to rollback app version. Status code: %d. \n%s",
-			response.StatusCode, responseBody)
+		return nil, apphttp.NewApptrustError("failed to rollback app version", response, responseBody)
 	}
 
-	log.Output(string(responseBody))
-	return nil
+	return apphttp.DecodeResponse("rollback app version", responseBody,
+		&model.RollbackAppVersionResponse{ApplicationKey: applicationKey, Version: version, RollbackFromStage: request.FromStage})
 }
 
 func (vs *versionService) DeleteAppVersion(ctx service.Context, applicationKey, version string) error {
@@ -104,11 +107,9 @@ func (vs *versionService) DeleteAppVersion(ctx service.Context, applicationKey,
 	}
 
 	if response.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("failed to delete app version. Status code: %d.\n%s",
-			response.StatusCode, responseBody)
+		return apphttp.NewApptrustError("failed to delete app version", response, responseBody)
 	}
 
-	log.Info("Application version deleted successfully.")
 	return nil
 }
 
@@ -120,15 +121,13 @@ func (vs *versionService) UpdateAppVersion(ctx service.Context, applicationKey s
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to update app version. Status code: %d. \n%s",
-			response.StatusCode, responseBody)
+		return apphttp.NewApptrustError("failed to update app version", response, responseBody)
 	}
 
-	log.Info("Application version updated successfully.")
 	return nil
 }
 
-func (vs *versionService) UpdateAppVersionSources(ctx service.Context, applicationKey string, version string, request *model.UpdateVersionSourcesRequest, sync bool, dryRun bool, failFast bool) error {
+func (vs *versionService) UpdateAppVersionSources(ctx service.Context, applicationKey string, version string, request *model.UpdateVersionSourcesRequest, sync bool, dryRun bool, failFast bool) (*model.AppVersionResponse, error) {
 	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s", applicationKey, version)
 
 	params := map[string]string{
@@ -139,7 +138,7 @@ func (vs *versionService) UpdateAppVersionSources(ctx service.Context, applicati
 
 	response, responseBody, err := ctx.GetHttpClient().Patch(endpoint, request, params)
 	if err != nil {
-		return err
+		return nil, interruptedError(err, applicationKey, version)
 	}
 
 	expectedStatusCode := http.StatusOK
@@ -148,21 +147,133 @@ func (vs *versionService) UpdateAppVersionSources(ctx service.Context, applicati
 	}
 
 	if response.StatusCode != expectedStatusCode {
-		return fmt.Errorf("failed to update app version sources. Status code: %d. \n%s",
-			response.StatusCode, responseBody)
+		return nil, apphttp.NewApptrustError("failed to update app version sources", response, responseBody)
 	}
 
-	log.Info("Application version sources updated successfully.")
-	log.Output(string(responseBody))
-	return nil
+	return apphttp.DecodeResponse("update app version sources", responseBody, &model.AppVersionResponse{ApplicationKey: applicationKey, Version: version})
 }
 
-func logSuccessMessage(sync bool, request *model.CreateAppVersionRequest, dryRun bool) {
-	if !sync {
-		log.Info(fmt.Sprintf("Application version creation initiated: %s:%s", request.ApplicationKey, request.Version))
-	} else if dryRun {
-		log.Info(fmt.Sprintf("Dry run successful for application version: %s:%s", request.ApplicationKey, request.Version))
-	} else {
-		log.Info(fmt.Sprintf("Application version created successfully: %s:%s", request.ApplicationKey, request.Version))
+// newPromoteAppVersionResponse returns the result of a promotion or a release, as far as the request tells it.
+func newPromoteAppVersionResponse(applicationKey, version, targetStage string, request model.CommonPromoteAppVersion) *model.PromoteAppVersionResponse {
+	return &model.PromoteAppVersionResponse{
+		ApplicationKey:               applicationKey,
+		Version:                      version,
+		TargetStage:                  targetStage,
+		PromotionType:                request.PromotionType,
+		IncludedRepositoryKeys:       request.IncludedRepositoryKeys,
+		ExcludedRepositoryKeys:       request.ExcludedRepositoryKeys,
+		ArtifactAdditionalProperties: request.ArtifactAdditionalProperties,
+		OverwriteStrategy:            request.OverwriteStrategy,
+	}
+}
+
+// ListAppVersions returns an iterator over the versions of the application that match the request.
+// The server filters and orders the versions, and pages are requested as the versions are consumed.
+// Sorting by semantic version isn't supported by the server, so all the versions are fetched and sorted
+// before the first one is returned.
+func (vs *versionService) ListAppVersions(ctx service.Context, applicationKey string, request *model.ListAppVersionsRequest) iter.Seq2[model.AppVersion, error] {
+	if request == nil {
+		request = &model.ListAppVersionsRequest{}
+	}
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions", applicationKey)
+	options := apphttp.PageOptions{Params: buildListAppVersionsParams(request), Operation: "failed to list app versions"}
+	pages := apphttp.Paginate(ctx.GetHttpClient(), endpoint, options, func(body []byte) (*apphttp.Page[model.AppVersion], error) {
+		var page model.ListAppVersionsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the list app versions response: %s", err.Error())
+		}
+		return &apphttp.Page[model.AppVersion]{Items: page.Versions, Total: page.Total, NextCursor: page.NextCursor}, nil
+	})
+	if request.SortBy == model.VersionSortBySemver {
+		pages = sortedAppVersions(pages, request.SortOrder)
+	}
+	return apphttp.Limit(pages, request.MaxItems)
+}
+
+func buildListAppVersionsParams(request *model.ListAppVersionsRequest) map[string]string {
+	params := map[string]string{}
+	if request.Stage != "" {
+		params["stage"] = request.Stage
+	}
+	if request.Tag != "" {
+		params["tag"] = request.Tag
+	}
+	if request.ReleaseStatus != "" {
+		params["release_status"] = request.ReleaseStatus
+	}
+	if request.Draft != nil {
+		params["draft"] = strconv.FormatBool(*request.Draft)
+	}
+	if !request.CreatedAfter.IsZero() {
+		params["created_after"] = request.CreatedAfter.UTC().Format(time.RFC3339)
+	}
+	if !request.CreatedBefore.IsZero() {
+		params["created_before"] = request.CreatedBefore.UTC().Format(time.RFC3339)
+	}
+	if request.SortBy == model.VersionSortByCreated {
+		params["sort_by"] = request.SortBy
+		if request.SortOrder != "" {
+			params["order"] = request.SortOrder
+		}
+	}
+	return params
+}
+
+// sortedAppVersions collects all the pages and returns the versions sorted by semantic version.
+func sortedAppVersions(pages iter.Seq2[model.AppVersion, error], sortOrder string) iter.Seq2[model.AppVersion, error] {
+	return func(yield func(model.AppVersion, error) bool) {
+		appVersions, err := apphttp.Collect(pages)
+		if err == nil {
+			sortAppVersions(appVersions, sortOrder)
+		}
+		apphttp.Sequence(appVersions, err)(yield)
+	}
+}
+
+func (vs *versionService) GetAppVersion(ctx service.Context, applicationKey string, version string, includeArtifacts bool) (*model.VersionContentResponse, error) {
+	endpoint := fmt.Sprintf("/v1/applications/%s/versions/%s/content", applicationKey, version)
+	// The expanded releasables include the full list of artifacts of each releasable.
+	include := "releasables"
+	if includeArtifacts {
+		include = "releasables_expanded"
+	}
+	response, responseBody, err := ctx.GetHttpClient().Get(endpoint, map[string]string{"include": include})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, apphttp.NewApptrustError("failed to get app version", response, responseBody)
+	}
+
+	versionContent := new(model.VersionContentResponse)
+	if err = json.Unmarshal(responseBody, versionContent); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the app version response: %s", err.Error())
+	}
+	return versionContent, nil
+}
+
+// InterruptedError is returned by an operation that may complete asynchronously, when its request is canceled
+// by a timeout or by the user. The operation may still be running server-side, since the server may have received
+// the request before the cancellation.
+type InterruptedError struct {
+	ApplicationKey string
+	Version        string
+	Err            error
+}
+
+func (e *InterruptedError) Error() string {
+	return e.Err.Error() + "\nThe operation may still be running server-side"
+}
+
+func (e *InterruptedError) Unwrap() error {
+	return e.Err
+}
+
+// interruptedError returns an InterruptedError for a canceled request, and err as is otherwise.
+func interruptedError(err error, applicationKey, version string) error {
+	if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+		return err
 	}
+	return &InterruptedError{ApplicationKey: applicationKey, Version: version, Err: err}
 }
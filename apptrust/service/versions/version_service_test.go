@@ -1,17 +1,23 @@
 package versions
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"maps"
 	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
 	mockhttp "github.com/jfrog/jfrog-cli-application/apptrust/http/mocks"
 	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
 	"go.uber.org/mock/gomock"
 
 	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateAppVersion(t *testing.T) {
@@ -101,7 +107,7 @@ func TestCreateAppVersion(t *testing.T) {
 			mockCtx := mockservice.NewMockContext(ctrl)
 			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
 
-			err := service.CreateAppVersion(mockCtx, tt.request, tt.sync, tt.dryRun)
+			_, err := service.CreateAppVersion(mockCtx, tt.request, tt.sync, tt.dryRun)
 			if tt.expectedError == "" {
 				assert.NoError(t, err)
 			} else {
@@ -213,7 +219,7 @@ func TestPromoteAppVersion(t *testing.T) {
 			mockCtx := mockservice.NewMockContext(ctrl)
 			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
 
-			err := service.PromoteAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
+			_, err := service.PromoteAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
 			if tt.expectedError == "" {
 				assert.NoError(t, err)
 			} else {
@@ -325,7 +331,7 @@ func TestReleaseAppVersion(t *testing.T) {
 			mockCtx := mockservice.NewMockContext(ctrl)
 			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
 
-			err := service.ReleaseAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
+			_, err := service.ReleaseAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
 			if tt.expectedError == "" {
 				assert.NoError(t, err)
 			} else {
@@ -517,7 +523,7 @@ func TestRollbackAppVersion(t *testing.T) {
 				Return(&http.Response{StatusCode: tt.expectedStatus}, []byte(""), nil)
 
 			service := NewVersionService()
-			err := service.RollbackAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
+			_, err := service.RollbackAppVersion(mockCtx, tt.applicationKey, tt.version, tt.payload, tt.sync)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -649,7 +655,7 @@ func TestUpdateAppVersionSources(t *testing.T) {
 			mockCtx := mockservice.NewMockContext(ctrl)
 			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
 
-			err := service.UpdateAppVersionSources(mockCtx, "test-app", "1.0.0", tt.request, tt.sync, tt.dryRun, tt.failFast)
+			_, err := service.UpdateAppVersionSources(mockCtx, "test-app", "1.0.0", tt.request, tt.sync, tt.dryRun, tt.failFast)
 			if tt.expectError {
 				assert.Error(t, err)
 				if tt.errorMsg != "" {
@@ -661,3 +667,405 @@ func TestUpdateAppVersionSources(t *testing.T) {
 		})
 	}
 }
+
+func TestListAppVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := NewVersionService()
+	endpoint := "/v1/applications/test-app/versions"
+	draft := false
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	params := map[string]string{
+		"stage":          "QA",
+		"tag":            "rc",
+		"release_status": "released",
+		"draft":          "false",
+		"created_after":  "2025-01-01T00:00:00Z",
+		"created_before": "2025-02-01T00:00:00Z",
+	}
+	firstPageParams := maps.Clone(params)
+	firstPageParams["offset"], firstPageParams["limit"] = "0", "100"
+	secondPageParams := maps.Clone(params)
+	secondPageParams["offset"], secondPageParams["limit"] = "2", "100"
+	gomock.InOrder(
+		mockHttpClient.EXPECT().Get(endpoint, firstPageParams).
+			Return(&http.Response{StatusCode: http.StatusOK},
+				[]byte(`{"versions":[{"version":"1.9.0","release_status":"released"},{"version":"1.10.0","release_status":"released"}],"total":3}`), nil),
+		mockHttpClient.EXPECT().Get(endpoint, secondPageParams).
+			Return(&http.Response{StatusCode: http.StatusOK},
+				[]byte(`{"versions":[{"version":"1.2.0","release_status":"released"}],"total":3}`), nil),
+	)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+	// The server can't sort by semantic version, so no sort parameters are sent and all the pages are sorted.
+	appVersions, err := apphttp.Collect(service.ListAppVersions(mockCtx, "test-app", &model.ListAppVersionsRequest{
+		Stage:         "QA",
+		Tag:           "rc",
+		ReleaseStatus: model.ReleaseStatusReleased,
+		Draft:         &draft,
+		CreatedAfter:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		CreatedBefore: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		SortBy:        model.VersionSortBySemver,
+		SortOrder:     model.SortOrderDesc,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.10.0", "1.9.0", "1.2.0"}, versionNames(appVersions))
+}
+
+func TestListAppVersions_Streams(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        *model.ListAppVersionsRequest
+		expectedParams map[string]string
+	}{
+		{
+			name:           "server order",
+			request:        &model.ListAppVersionsRequest{ReleaseStatus: model.ReleaseStatusReleased},
+			expectedParams: map[string]string{"release_status": "released", "offset": "0", "limit": "100"},
+		},
+		{
+			name:           "sorted by creation time",
+			request:        &model.ListAppVersionsRequest{SortBy: model.VersionSortByCreated, SortOrder: model.SortOrderAsc},
+			expectedParams: map[string]string{"sort_by": "created", "order": "asc", "offset": "0", "limit": "100"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			// Only the first page is requested, since iteration stops at the first version.
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions", tt.expectedParams).
+				Return(&http.Response{StatusCode: http.StatusOK},
+					[]byte(`{"versions":[{"version":"1.1.0"},{"version":"1.2.0"}],"total":300}`), nil).
+				Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+			var first model.AppVersion
+			for appVersion, err := range NewVersionService().ListAppVersions(mockCtx, "test-app", tt.request) {
+				require.NoError(t, err)
+				first = appVersion
+				break
+			}
+			assert.Equal(t, "1.1.0", first.Version)
+		})
+	}
+}
+
+func TestListAppVersions_MaxItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoint := "/v1/applications/test-app/versions"
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	gomock.InOrder(
+		mockHttpClient.EXPECT().Get(endpoint, map[string]string{"offset": "0", "limit": "100"}).
+			Return(&http.Response{StatusCode: http.StatusOK},
+				[]byte(`{"versions":[{"version":"1.2.0"},{"version":"1.10.0"}],"total":3}`), nil),
+		mockHttpClient.EXPECT().Get(endpoint, map[string]string{"offset": "2", "limit": "100"}).
+			Return(&http.Response{StatusCode: http.StatusOK},
+				[]byte(`{"versions":[{"version":"1.9.0"}],"total":3}`), nil),
+	)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+
+	// All the versions are fetched to sort them, and the first ones in the sort order are returned.
+	appVersions, err := apphttp.Collect(NewVersionService().ListAppVersions(mockCtx, "test-app", &model.ListAppVersionsRequest{
+		SortBy:    model.VersionSortBySemver,
+		SortOrder: model.SortOrderDesc,
+		MaxItems:  2,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, []model.AppVersion{{Version: "1.10.0"}, {Version: "1.9.0"}}, appVersions)
+}
+
+func TestListAppVersions_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  *http.Response
+		mockBody      []byte
+		mockError     error
+		expectedError string
+	}{
+		{
+			name:          "failure status code",
+			mockResponse:  &http.Response{StatusCode: http.StatusNotFound},
+			mockBody:      []byte("not found"),
+			expectedError: "failed to list app versions. Status code: 404.\nnot found",
+		},
+		{
+			name:          "invalid response body",
+			mockResponse:  &http.Response{StatusCode: http.StatusOK},
+			mockBody:      []byte("not-json"),
+			expectedError: "failed to parse the list app versions response",
+		},
+		{
+			name:          "http client error",
+			mockError:     errors.New("http client error"),
+			expectedError: "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions", gomock.Any()).
+				Return(tt.mockResponse, tt.mockBody, tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			appVersions, err := apphttp.Collect(NewVersionService().ListAppVersions(mockCtx, "test-app", nil))
+			assert.ErrorContains(t, err, tt.expectedError)
+			assert.Nil(t, appVersions)
+		})
+	}
+}
+
+func TestGetAppVersion(t *testing.T) {
+	tests := []struct {
+		name             string
+		includeArtifacts bool
+		expectedInclude  string
+		mockResponse     *http.Response
+		mockBody         string
+		mockError        error
+		expectedResult   *model.VersionContentResponse
+		expectedError    string
+	}{
+		{
+			name:             "success with artifacts",
+			includeArtifacts: true,
+			expectedInclude:  "releasables_expanded",
+			mockResponse:     &http.Response{StatusCode: http.StatusOK},
+			mockBody: `{"application_key":"test-app","version":"1.0.0","status":"COMPLETED","current_stage":"QA","tag":"rc",
+				"properties":{"team":["core"]},
+				"releasables":[{"name":"pkg","version":"2.0.0","package_type":"npm",
+					"sources":[{"type":"build","name":"my-build","version":"12"}],
+					"artifacts":[{"path":"npm-local/pkg/-/pkg-2.0.0.tgz","sha256":"abc"}]}]}`,
+			expectedResult: &model.VersionContentResponse{
+				ApplicationKey: "test-app",
+				Version:        "1.0.0",
+				Status:         "COMPLETED",
+				CurrentStage:   "QA",
+				Tag:            "rc",
+				Properties:     map[string][]string{"team": {"core"}},
+				Releasables: []model.Releasable{{
+					Name:        "pkg",
+					Version:     "2.0.0",
+					PackageType: "npm",
+					Sources:     []model.ReleasableSource{{Type: "build", Name: "my-build", Version: "12"}},
+					Artifacts:   []model.ReleasableArtifact{{Path: "npm-local/pkg/-/pkg-2.0.0.tgz", SHA256: "abc"}},
+				}},
+			},
+		},
+		{
+			name:             "success without artifacts",
+			includeArtifacts: false,
+			expectedInclude:  "releasables",
+			mockResponse:     &http.Response{StatusCode: http.StatusOK},
+			mockBody:         `{"application_key":"test-app","version":"1.0.0","releasables":[]}`,
+			expectedResult: &model.VersionContentResponse{
+				ApplicationKey: "test-app",
+				Version:        "1.0.0",
+				Releasables:    []model.Releasable{},
+			},
+		},
+		{
+			name:            "not found",
+			expectedInclude: "releasables",
+			mockResponse:    &http.Response{StatusCode: http.StatusNotFound},
+			mockBody:        "not found",
+			expectedError:   "failed to get app version. Status code: 404.\nnot found",
+		},
+		{
+			name:            "invalid response body",
+			expectedInclude: "releasables",
+			mockResponse:    &http.Response{StatusCode: http.StatusOK},
+			mockBody:        "not-json",
+			expectedError:   "failed to parse the app version response",
+		},
+		{
+			name:            "http client error",
+			expectedInclude: "releasables",
+			mockError:       errors.New("http client error"),
+			expectedError:   "http client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", map[string]string{"include": tt.expectedInclude}).
+				Return(tt.mockResponse, []byte(tt.mockBody), tt.mockError).Times(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			result, err := NewVersionService().GetAppVersion(mockCtx, "test-app", "1.0.0", tt.includeArtifacts)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestVersionService_DecodesResponses(t *testing.T) {
+	const appVersionBody = `{"application_key":"test-app","version":"1.0.0","project_key":"proj","status":"STARTED","tag":"rc"}`
+	const promotionBody = `{"application_key":"test-app","version":"1.0.0","source_stage":"DEV","target_stage":"QA","promotion_type":"copy",` +
+		`"included_repository_keys":["npm-qa"],"artifact_additional_properties":[{"key":"team","values":["web"]}],"status":"COMPLETED","created_millis":1700000000000}`
+	expectedAppVersion := &model.AppVersionResponse{ApplicationKey: "test-app", Version: "1.0.0", ProjectKey: "proj", Status: "STARTED", Tag: "rc"}
+	expectedPromotion := &model.PromoteAppVersionResponse{ApplicationKey: "test-app", Version: "1.0.0", SourceStage: "DEV", TargetStage: "QA", PromotionType: "copy",
+		IncludedRepositoryKeys: []string{"npm-qa"}, ArtifactAdditionalProperties: []model.ArtifactProperty{{Key: "team", Values: []string{"web"}}}, Status: "COMPLETED", CreatedMillis: 1700000000000}
+
+	tests := []struct {
+		name           string
+		mockBody       string
+		call           func(VersionService, *mockservice.MockContext) (interface{}, error)
+		expectedResult interface{}
+		expectedError  string
+	}{
+		{
+			name:     "create",
+			mockBody: appVersionBody,
+			call: func(vs VersionService, ctx *mockservice.MockContext) (interface{}, error) {
+				return vs.CreateAppVersion(ctx, &model.CreateAppVersionRequest{ApplicationKey: "test-app", Version: "1.0.0"}, false, false)
+			},
+			expectedResult: expectedAppVersion,
+		},
+		{
+			name:     "update sources",
+			mockBody: appVersionBody,
+			call: func(vs VersionService, ctx *mockservice.MockContext) (interface{}, error) {
+				return vs.UpdateAppVersionSources(ctx, "test-app", "1.0.0", &model.UpdateVersionSourcesRequest{}, true, false, true)
+			},
+			expectedResult: expectedAppVersion,
+		},
+		{
+			name:     "promote",
+			mockBody: promotionBody,
+			call: func(vs VersionService, ctx *mockservice.MockContext) (interface{}, error) {
+				return vs.PromoteAppVersion(ctx, "test-app", "1.0.0", &model.PromoteAppVersionRequest{Stage: "QA"}, true)
+			},
+			expectedResult: expectedPromotion,
+		},
+		{
+			name:     "release",
+			mockBody: promotionBody,
+			call: func(vs VersionService, ctx *mockservice.MockContext) (interface{}, error) {
+				return vs.ReleaseAppVersion(ctx, "test-app", "1.0.0", &model.ReleaseAppVersionRequest{}, true)
+			},
+			expectedResult: expectedPromotion,
+		},
+		{
+			name:     "rollback",
+			mockBody: `{"application_key":"test-app","version":"1.0.0","project_key":"proj","rollback_from_stage":"QA","rollback_to_stage":"DEV"}`,
+			call: func(vs VersionService, ctx *mockservice.MockContext) (interface{}, error) {
+				return vs.RollbackAppVersion(ctx, "test-app", "1.0.0", model.NewRollbackAppVersionRequest("QA"), true)
+			},
+			expectedResult: &model.RollbackAppVersionResponse{ApplicationKey: "test-app", Version: "1.0.0", ProjectKey: "proj", RollbackFromStage: "QA", RollbackToStage: "DEV"},
+		},
+		{
+			name:     "empty body",
+			mockBody: "",
+			call: func(vs VersionService, ctx *mockservice.MockContext) (interface{}, error) {
+				request := &model.PromoteAppVersionRequest{Stage: "QA", CommonPromoteAppVersion: model.CommonPromoteAppVersion{PromotionType: "move"}}
+				return vs.PromoteAppVersion(ctx, "test-app", "1.0.0", request, false)
+			},
+			expectedResult: &model.PromoteAppVersionResponse{ApplicationKey: "test-app", Version: "1.0.0", TargetStage: "QA", PromotionType: "move"},
+		},
+		{
+			name:     "invalid body",
+			mockBody: "not-json",
+			call: func(vs VersionService, ctx *mockservice.MockContext) (interface{}, error) {
+				return vs.RollbackAppVersion(ctx, "test-app", "1.0.0", model.NewRollbackAppVersionRequest("QA"), true)
+			},
+			expectedError: "failed to parse the rollback app version response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&http.Response{StatusCode: http.StatusOK}, []byte(tt.mockBody), nil).AnyTimes()
+			mockHttpClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&http.Response{StatusCode: http.StatusOK}, []byte(tt.mockBody), nil).AnyTimes()
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+			result, err := tt.call(NewVersionService(), mockCtx)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestCreateAppVersion_ApptrustError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&http.Response{StatusCode: http.StatusConflict},
+			[]byte(`{"code":"VERSION_EXISTS","message":"Version 1.0.0 already exists","request_id":"req-1"}`), nil)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+	_, err := NewVersionService().CreateAppVersion(mockCtx, &model.CreateAppVersionRequest{ApplicationKey: "test-app", Version: "1.0.0"}, true, false)
+
+	var apptrustError *apphttp.ApptrustError
+	require.ErrorAs(t, err, &apptrustError)
+	assert.Equal(t, http.StatusConflict, apptrustError.StatusCode)
+	assert.Equal(t, "VERSION_EXISTS", apptrustError.Code)
+	assert.Equal(t, "req-1", apptrustError.RequestId)
+	assert.Equal(t, apphttp.ExitCodeConflict, apptrustError.ExitCode())
+}
+
+func TestPromoteAppVersion_Interrupted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil, fmt.Errorf("POST request was canceled: %w", context.Canceled))
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+
+	_, err := NewVersionService().PromoteAppVersion(mockCtx, "test-app", "1.0.0", &model.PromoteAppVersionRequest{Stage: "QA"}, true)
+	assert.ErrorIs(t, err, context.Canceled)
+	var interruptedErr *InterruptedError
+	require.ErrorAs(t, err, &interruptedErr)
+	assert.Equal(t, "test-app", interruptedErr.ApplicationKey)
+	assert.Equal(t, "1.0.0", interruptedErr.Version)
+	assert.ErrorContains(t, err, "The operation may still be running server-side")
+}
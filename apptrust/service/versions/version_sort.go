@@ -0,0 +1,33 @@
+package versions
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/gofrog/version"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+// sortAppVersions sorts the versions in place by semantic version.
+func sortAppVersions(versions []model.AppVersion, sortOrder string) {
+	less := func(i, j int) bool {
+		// Compare returns 1 when the given version is greater than the receiver.
+		return version.NewVersion(versions[i].Version).Compare(versions[j].Version) > 0
+	}
+	if sortOrder == model.SortOrderDesc {
+		sort.SliceStable(versions, func(i, j int) bool { return less(j, i) })
+		return
+	}
+	sort.SliceStable(versions, less)
+}
+
+func compareCreationTimes(first, second string) int {
+	firstTime, firstErr := time.Parse(time.RFC3339, first)
+	secondTime, secondErr := time.Parse(time.RFC3339, second)
+	if firstErr != nil || secondErr != nil {
+		return strings.Compare(first, second)
+	}
+	return firstTime.Compare(secondTime)
+}
@@ -0,0 +1,36 @@
+package versions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+func TestSortAppVersions(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortOrder string
+		expected  []string
+	}{
+		{"ascending", model.SortOrderAsc, []string{"1.2.0", "1.9.1", "1.10.0", "2.0.0"}},
+		{"descending", model.SortOrderDesc, []string{"2.0.0", "1.10.0", "1.9.1", "1.2.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appVersions := []model.AppVersion{{Version: "1.2.0"}, {Version: "1.10.0"}, {Version: "1.9.1"}, {Version: "2.0.0"}}
+			sortAppVersions(appVersions, tt.sortOrder)
+			assert.Equal(t, tt.expected, versionNames(appVersions))
+		})
+	}
+}
+
+func versionNames(appVersions []model.AppVersion) []string {
+	names := []string{}
+	for _, appVersion := range appVersions {
+		names = append(names, appVersion.Version)
+	}
+	return names
+}
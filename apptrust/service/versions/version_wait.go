@@ -0,0 +1,158 @@
+package versions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+
+	apphttp "github.com/jfrog/jfrog-cli-application/apptrust/http"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/jfrog/jfrog-cli-application/apptrust/service"
+)
+
+// maxWaitInterval caps the exponential backoff between two polls of the version status.
+const maxWaitInterval = 30 * time.Second
+
+func (vs *versionService) WaitForAppVersion(ctx service.Context, applicationKey, version string, request *model.WaitAppVersionRequest) (*model.VersionContentResponse, error) {
+	deadline := time.Now().Add(request.Timeout)
+	interval := request.Interval
+	var versionContent *model.VersionContentResponse
+	for {
+		polledContent, err := vs.GetAppVersion(ctx, applicationKey, version, false)
+		var promotion *model.VersionHistoryEvent
+		if err == nil && request.State != model.WaitStateCreated && strings.EqualFold(polledContent.Status, model.VersionStatusCompleted) {
+			promotion, err = vs.latestPromotion(ctx, applicationKey, version, request.Stage)
+		}
+		if err != nil {
+			if !isWaitRetryable(ctx, err) {
+				return versionContent, err
+			}
+			ctx.GetLogger().Debug(fmt.Sprintf("Failed to get application version %s:%s, which is retried: %s", applicationKey, version, err.Error()))
+		} else {
+			versionContent = polledContent
+			done, err := isWaitStateReached(versionContent, promotion, request)
+			if err != nil {
+				return versionContent, err
+			}
+			if done {
+				return versionContent, nil
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return versionContent, waitTimeoutError(applicationKey, version, request, versionContent, err)
+		}
+
+		if versionContent != nil {
+			ctx.GetLogger().Debug(fmt.Sprintf("Application version %s:%s status is %s. Checking again in %s.", applicationKey, version, versionContent.Status, interval))
+		}
+		timer := time.NewTimer(min(interval, remaining))
+		select {
+		case <-ctx.GetContext().Done():
+			timer.Stop()
+			return versionContent, fmt.Errorf("stopped waiting for application version %s:%s to reach the '%s' state: %w",
+				applicationKey, version, request.State, context.Cause(ctx.GetContext()))
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxWaitInterval)
+	}
+}
+
+// isWaitRetryable returns true when a failed poll should be treated as a version that didn't reach the state yet:
+// a version that isn't found yet, e.g., right after an asynchronous version creation, a server or rate limit error,
+// or a network error. Other client errors, and the cancellation of the request context, stop the wait.
+func isWaitRetryable(ctx service.Context, err error) bool {
+	if ctx.GetContext().Err() != nil {
+		return false
+	}
+	var apptrustError *apphttp.ApptrustError
+	if !errors.As(err, &apptrustError) {
+		return true
+	}
+	statusCode := apptrustError.StatusCode
+	return statusCode == http.StatusNotFound || statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+func waitTimeoutError(applicationKey, version string, request *model.WaitAppVersionRequest, versionContent *model.VersionContentResponse, lastErr error) error {
+	message := fmt.Sprintf("timed out after %s waiting for application version %s:%s to reach the '%s' state",
+		request.Timeout, applicationKey, version, request.State)
+	if lastErr != nil {
+		return errorutils.CheckErrorf("%s. The last status check failed: %s", message, lastErr.Error())
+	}
+	return errorutils.CheckErrorf("%s (status: %s, current stage: %s)", message, versionContent.Status, versionContent.CurrentStage)
+}
+
+// latestPromotion returns the latest promotion or release of the version, to the given stage if it is set,
+// or nil if the version history has none.
+func (vs *versionService) latestPromotion(ctx service.Context, applicationKey, version, stage string) (*model.VersionHistoryEvent, error) {
+	events, err := vs.GetAppVersionHistory(ctx, applicationKey, version)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.EventType != model.VersionEventPromotion && event.EventType != model.VersionEventRelease {
+			continue
+		}
+		if stage == "" || strings.EqualFold(event.TargetStage, stage) {
+			return &event, nil
+		}
+	}
+	return nil, nil
+}
+
+// isWaitStateReached returns true when the version reached the requested state,
+// and an error when the version reached a state from which the requested state can't be reached.
+// The promoted and failed states are decided by the outcome of the latest promotion, once the version is created.
+func isWaitStateReached(versionContent *model.VersionContentResponse, promotion *model.VersionHistoryEvent, request *model.WaitAppVersionRequest) (bool, error) {
+	failed := strings.EqualFold(versionContent.Status, model.VersionStatusFailed)
+	switch request.State {
+	case model.WaitStateFailed:
+		if failed {
+			return true, nil
+		}
+		if promotion == nil {
+			if strings.EqualFold(versionContent.Status, model.VersionStatusCompleted) {
+				return false, errorutils.CheckErrorf("application version %s:%s completed and didn't fail", versionContent.ApplicationKey, versionContent.Version)
+			}
+			return false, nil
+		}
+		if strings.EqualFold(promotion.Status, model.VersionStatusCompleted) {
+			return false, errorutils.CheckErrorf("the promotion of application version %s:%s to the '%s' stage completed and didn't fail",
+				versionContent.ApplicationKey, versionContent.Version, promotion.TargetStage)
+		}
+		return strings.EqualFold(promotion.Status, model.VersionStatusFailed), nil
+	case model.WaitStatePromoted:
+		if failed {
+			return false, errorutils.CheckErrorf("application version %s:%s failed", versionContent.ApplicationKey, versionContent.Version)
+		}
+		if promotion == nil {
+			return false, nil
+		}
+		if strings.EqualFold(promotion.Status, model.VersionStatusFailed) {
+			return false, promotionFailedError(versionContent, promotion)
+		}
+		return strings.EqualFold(promotion.Status, model.VersionStatusCompleted), nil
+	default:
+		if failed {
+			return false, errorutils.CheckErrorf("application version %s:%s creation failed", versionContent.ApplicationKey, versionContent.Version)
+		}
+		return strings.EqualFold(versionContent.Status, model.VersionStatusCompleted), nil
+	}
+}
+
+func promotionFailedError(versionContent *model.VersionContentResponse, promotion *model.VersionHistoryEvent) error {
+	message := fmt.Sprintf("the promotion of application version %s:%s to the '%s' stage failed",
+		versionContent.ApplicationKey, versionContent.Version, promotion.TargetStage)
+	if len(promotion.Messages) > 0 {
+		return errorutils.CheckErrorf("%s: %s", message, strings.Join(promotion.Messages, "; "))
+	}
+	return errorutils.CheckErrorf("%s", message)
+}
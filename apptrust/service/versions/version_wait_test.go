@@ -0,0 +1,293 @@
+package versions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mockhttp "github.com/jfrog/jfrog-cli-application/apptrust/http/mocks"
+	mockservice "github.com/jfrog/jfrog-cli-application/apptrust/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+)
+
+func TestWaitForAppVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *model.WaitAppVersionRequest
+		statuses      [][2]string
+		expectedPolls int
+		expectedError string
+	}{
+		{
+			name:          "created after polling",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStateCreated},
+			statuses:      [][2]string{{"STARTED", ""}, {"IN_PROGRESS", ""}, {"COMPLETED", ""}},
+			expectedPolls: 3,
+		},
+		{
+			name:          "creation failed",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStateCreated},
+			statuses:      [][2]string{{"IN_PROGRESS", ""}, {"FAILED", ""}},
+			expectedPolls: 2,
+			expectedError: "application version test-app:1.0.0 creation failed",
+		},
+		{
+			name:          "creation failed while waiting for promotion",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStatePromoted, Stage: "QA"},
+			statuses:      [][2]string{{"IN_PROGRESS", ""}, {"FAILED", ""}},
+			expectedPolls: 2,
+			expectedError: "application version test-app:1.0.0 failed",
+		},
+		{
+			name:          "waiting for failure",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStateFailed},
+			statuses:      [][2]string{{"IN_PROGRESS", ""}, {"FAILED", ""}},
+			expectedPolls: 2,
+		},
+		{
+			name:          "timeout",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStateCreated, Timeout: 5 * time.Millisecond},
+			statuses:      [][2]string{{"IN_PROGRESS", ""}},
+			expectedError: "timed out after 5ms waiting for application version test-app:1.0.0 to reach the 'created' state (status: IN_PROGRESS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			if tt.request.Timeout == 0 {
+				tt.request.Timeout = time.Minute
+			}
+			tt.request.Interval = time.Millisecond
+
+			polls := 0
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+				DoAndReturn(func(_ string, _ map[string]string) (*http.Response, []byte, error) {
+					status := tt.statuses[min(polls, len(tt.statuses)-1)]
+					polls++
+					body := fmt.Sprintf(`{"application_key":"test-app","version":"1.0.0","status":"%s","current_stage":"%s"}`, status[0], status[1])
+					return &http.Response{StatusCode: http.StatusOK}, []byte(body), nil
+				}).MinTimes(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+			mockCtx.EXPECT().GetContext().Return(context.Background()).AnyTimes()
+			mockCtx.EXPECT().GetLogger().Return(newDiscardLogger(ctrl)).AnyTimes()
+
+			versionContent, err := NewVersionService().WaitForAppVersion(mockCtx, "test-app", "1.0.0", tt.request)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NotNil(t, versionContent)
+			if tt.expectedPolls > 0 {
+				assert.Equal(t, tt.expectedPolls, polls)
+			}
+		})
+	}
+}
+
+func TestWaitForAppVersion_Promotion(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *model.WaitAppVersionRequest
+		histories     []string
+		expectedPolls int
+		expectedError string
+	}{
+		{
+			name:    "promoted to stage",
+			request: &model.WaitAppVersionRequest{State: model.WaitStatePromoted, Stage: "QA"},
+			histories: []string{
+				`{"event_type":"promotion","target_stage":"DEV","status":"COMPLETED"}`,
+				`{"event_type":"promotion","target_stage":"DEV","status":"COMPLETED"},{"event_type":"promotion","target_stage":"QA","status":"IN_PROGRESS"}`,
+				`{"event_type":"promotion","target_stage":"DEV","status":"COMPLETED"},{"event_type":"promotion","target_stage":"QA","status":"COMPLETED"}`,
+			},
+			expectedPolls: 3,
+		},
+		{
+			name:          "released",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStatePromoted, Stage: "PROD"},
+			histories:     []string{`{"event_type":"release","target_stage":"PROD","status":"COMPLETED"}`},
+			expectedPolls: 1,
+		},
+		{
+			name:    "promotion failed",
+			request: &model.WaitAppVersionRequest{State: model.WaitStatePromoted, Stage: "QA"},
+			histories: []string{
+				`{"event_type":"promotion","target_stage":"QA","status":"IN_PROGRESS"}`,
+				`{"event_type":"promotion","target_stage":"QA","status":"FAILED","messages":["blocked by policy"]}`,
+			},
+			expectedPolls: 2,
+			expectedError: "the promotion of application version test-app:1.0.0 to the 'QA' stage failed: blocked by policy",
+		},
+		{
+			name:    "waiting for a failed promotion",
+			request: &model.WaitAppVersionRequest{State: model.WaitStateFailed},
+			histories: []string{
+				`{"event_type":"promotion","target_stage":"QA","status":"IN_PROGRESS"}`,
+				`{"event_type":"promotion","target_stage":"QA","status":"FAILED"}`,
+			},
+			expectedPolls: 2,
+		},
+		{
+			name:          "promotion completed while waiting for failure",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStateFailed},
+			histories:     []string{`{"event_type":"promotion","target_stage":"QA","status":"COMPLETED"}`},
+			expectedPolls: 1,
+			expectedError: "the promotion of application version test-app:1.0.0 to the 'QA' stage completed and didn't fail",
+		},
+		{
+			name:          "created without promotions while waiting for failure",
+			request:       &model.WaitAppVersionRequest{State: model.WaitStateFailed},
+			histories:     []string{""},
+			expectedPolls: 1,
+			expectedError: "application version test-app:1.0.0 completed and didn't fail",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.request.Timeout = time.Minute
+			tt.request.Interval = time.Millisecond
+
+			polls := 0
+			mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+				Return(&http.Response{StatusCode: http.StatusOK}, []byte(`{"application_key":"test-app","version":"1.0.0","status":"COMPLETED"}`), nil).
+				MinTimes(1)
+			mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/history", gomock.Any()).
+				DoAndReturn(func(_ string, _ map[string]string) (*http.Response, []byte, error) {
+					events := tt.histories[min(polls, len(tt.histories)-1)]
+					polls++
+					return &http.Response{StatusCode: http.StatusOK}, []byte(`{"events":[` + events + `]}`), nil
+				}).MinTimes(1)
+
+			mockCtx := mockservice.NewMockContext(ctrl)
+			mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).AnyTimes()
+			mockCtx.EXPECT().GetContext().Return(context.Background()).AnyTimes()
+			mockCtx.EXPECT().GetLogger().Return(newDiscardLogger(ctrl)).AnyTimes()
+
+			_, err := NewVersionService().WaitForAppVersion(mockCtx, "test-app", "1.0.0", tt.request)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedPolls, polls)
+		})
+	}
+}
+
+func TestWaitForAppVersion_RetryableGetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	gomock.InOrder(
+		mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+			Return(&http.Response{StatusCode: http.StatusNotFound}, []byte("not found"), nil),
+		mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+			Return(&http.Response{StatusCode: http.StatusBadGateway}, []byte("bad gateway"), nil),
+		mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+			Return(&http.Response{StatusCode: http.StatusOK}, []byte(`{"application_key":"test-app","version":"1.0.0","status":"COMPLETED"}`), nil),
+	)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(3)
+	mockCtx.EXPECT().GetContext().Return(context.Background()).AnyTimes()
+
+	// The messages go to the logger of the client, rather than to the global logger.
+	var messages []string
+	mockLogger := mockhttp.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debug(gomock.Any()).Do(func(a ...interface{}) { messages = append(messages, fmt.Sprint(a...)) }).AnyTimes()
+	mockCtx.EXPECT().GetLogger().Return(mockLogger).AnyTimes()
+
+	versionContent, err := NewVersionService().WaitForAppVersion(mockCtx, "test-app", "1.0.0",
+		&model.WaitAppVersionRequest{State: model.WaitStateCreated, Timeout: time.Minute, Interval: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, model.VersionStatusCompleted, versionContent.Status)
+	require.Len(t, messages, 2)
+	assert.Contains(t, messages[0], "Failed to get application version test-app:1.0.0, which is retried: failed to get app version. Status code: 404")
+	assert.Contains(t, messages[1], "Status code: 502")
+}
+
+func TestWaitForAppVersion_RetryableGetErrorTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+		Return(&http.Response{StatusCode: http.StatusNotFound}, []byte("not found"), nil).MinTimes(1)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).MinTimes(1)
+	mockCtx.EXPECT().GetContext().Return(context.Background()).AnyTimes()
+	mockCtx.EXPECT().GetLogger().Return(newDiscardLogger(ctrl)).AnyTimes()
+
+	versionContent, err := NewVersionService().WaitForAppVersion(mockCtx, "test-app", "1.0.0",
+		&model.WaitAppVersionRequest{State: model.WaitStateCreated, Timeout: 5 * time.Millisecond, Interval: time.Millisecond})
+	assert.ErrorContains(t, err, "timed out after 5ms waiting for application version test-app:1.0.0 to reach the 'created' state. "+
+		"The last status check failed: failed to get app version. Status code: 404")
+	assert.Nil(t, versionContent)
+}
+
+func TestWaitForAppVersion_GetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+		Return(&http.Response{StatusCode: http.StatusForbidden}, []byte("forbidden"), nil).Times(1)
+
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+	mockCtx.EXPECT().GetContext().Return(context.Background()).AnyTimes()
+	mockCtx.EXPECT().GetLogger().Return(newDiscardLogger(ctrl)).AnyTimes()
+
+	versionContent, err := NewVersionService().WaitForAppVersion(mockCtx, "test-app", "1.0.0",
+		&model.WaitAppVersionRequest{State: model.WaitStateCreated, Timeout: time.Minute, Interval: time.Millisecond})
+	assert.ErrorContains(t, err, "failed to get app version. Status code: 403")
+	assert.Nil(t, versionContent)
+}
+
+func TestWaitForAppVersion_Canceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHttpClient := mockhttp.NewMockApptrustHttpClient(ctrl)
+	mockHttpClient.EXPECT().Get("/v1/applications/test-app/versions/1.0.0/content", gomock.Any()).
+		Return(&http.Response{StatusCode: http.StatusOK}, []byte(`{"application_key":"test-app","version":"1.0.0","status":"STARTED"}`), nil).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockCtx := mockservice.NewMockContext(ctrl)
+	mockCtx.EXPECT().GetHttpClient().Return(mockHttpClient).Times(1)
+	mockCtx.EXPECT().GetContext().Return(ctx).AnyTimes()
+	mockCtx.EXPECT().GetLogger().Return(newDiscardLogger(ctrl)).AnyTimes()
+
+	versionContent, err := NewVersionService().WaitForAppVersion(mockCtx, "test-app", "1.0.0",
+		&model.WaitAppVersionRequest{State: model.WaitStateCreated, Timeout: time.Minute, Interval: time.Minute})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "stopped waiting for application version test-app:1.0.0 to reach the 'created' state")
+	assert.NotNil(t, versionContent)
+}
+
+func newDiscardLogger(ctrl *gomock.Controller) *mockhttp.MockLogger {
+	logger := mockhttp.NewMockLogger(ctrl)
+	logger.EXPECT().Debug(gomock.Any()).AnyTimes()
+	return logger
+}
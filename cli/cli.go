@@ -4,6 +4,7 @@ import (
 	"github.com/jfrog/jfrog-cli-application/apptrust/app"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/application"
 	packagecmds "github.com/jfrog/jfrog-cli-application/apptrust/commands/package"
+	"github.com/jfrog/jfrog-cli-application/apptrust/commands/spec"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/system"
 	"github.com/jfrog/jfrog-cli-application/apptrust/commands/version"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
@@ -21,6 +22,7 @@ func GetJfrogCliApptrustApp() components.App {
 			Category:    "Command Namespaces",
 			Commands: []components.Command{
 				system.GetPingCommand(appContext),
+				system.GetDoctorCommand(appContext),
 				version.GetCreateAppVersionCommand(appContext),
 				version.GetPromoteAppVersionCommand(appContext),
 				version.GetRollbackAppVersionCommand(appContext),
@@ -28,11 +30,22 @@ func GetJfrogCliApptrustApp() components.App {
 				version.GetDeleteAppVersionCommand(appContext),
 				version.GetUpdateAppVersionCommand(appContext),
 				version.GetUpdateAppVersionSourcesCommand(appContext),
+				version.GetListAppVersionsCommand(appContext),
+				version.GetGetAppVersionCommand(appContext),
+				version.GetWaitAppVersionCommand(appContext),
+				version.GetAppVersionHistoryCommand(appContext),
+				version.GetDiffAppVersionsCommand(appContext),
 				packagecmds.GetBindPackageCommand(appContext),
 				packagecmds.GetUnbindPackageCommand(appContext),
+				packagecmds.GetListBoundPackagesCommand(appContext),
 				application.GetCreateAppCommand(appContext),
 				application.GetUpdateAppCommand(appContext),
 				application.GetDeleteAppCommand(appContext),
+				application.GetListAppsCommand(appContext),
+				application.GetGetAppCommand(appContext),
+				application.GetApplyAppCommand(appContext),
+				application.GetExportAppCommand(appContext),
+				spec.GetValidateSpecCommand(),
 			},
 		},
 	)
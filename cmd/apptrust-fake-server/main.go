@@ -0,0 +1,47 @@
+// Command apptrust-fake-server runs the in-memory fake AppTrust server on a local port,
+// for testing scripts and the CLI without a JFrog platform.
+//
+// Point the CLI to the printed URL, e.g.:
+//
+//	jf at ping --url http://localhost:8082/ --access-token fake-token
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/fakeserver"
+)
+
+func main() {
+	port := flag.Int("port", 8082, "The port to listen on. Use 0 to pick a free port.")
+	token := flag.String("token", "", "Accept only this bearer token. By default, any access token is accepted.")
+	seedFile := flag.String("seed", "", "A JSON file with the initial applications and the packages, builds and release bundles that version sources are resolved against.")
+	oidcProvider := flag.String("oidc-provider", "", "Enable the OIDC token exchange with the integration of this name. Any ID token is accepted.")
+	asyncDelay := flag.Duration("async-delay", fakeserver.DefaultAsyncDelay, "How long asynchronous operations stay in progress before they complete.")
+	flag.Parse()
+
+	server := fakeserver.New(fakeserver.WithAccessToken(*token), fakeserver.WithAsyncDelay(*asyncDelay),
+		fakeserver.WithOidcProvider(*oidcProvider))
+	if *seedFile != "" {
+		seed, err := fakeserver.LoadSeedFile(*seedFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = server.Seed(*seed); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", *port))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Fake AppTrust server listening on http://%s/\n", listener.Addr())
+	httpServer := &http.Server{Handler: server, ReadHeaderTimeout: 10 * time.Second}
+	log.Fatal(httpServer.Serve(listener))
+}
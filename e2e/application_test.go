@@ -3,11 +3,18 @@
 package e2e
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/e2e/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateApp(t *testing.T) {
@@ -47,6 +54,28 @@ func TestCreateApp(t *testing.T) {
 	utils.DeleteApplication(t, appKey)
 }
 
+func TestCreateApp_Plan(t *testing.T) {
+	projectKey := utils.GetTestProjectKey(t)
+	appKey := utils.GenerateUniqueKey("app-plan")
+
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "app-create", appKey, "--project="+projectKey, "--plan")
+	var planned struct {
+		Method   string              `json:"method"`
+		Endpoint string              `json:"endpoint"`
+		Payload  model.AppDescriptor `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &planned))
+	assert.Equal(t, http.MethodPost, planned.Method)
+	assert.True(t, strings.HasSuffix(planned.Endpoint, "/apptrust/api/v1/applications"), planned.Endpoint)
+	assert.Equal(t, appKey, planned.Payload.ApplicationKey)
+	assert.Equal(t, projectKey, planned.Payload.ProjectKey)
+
+	// The application isn't created.
+	_, statusCode, err := utils.GetApplication(appKey)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
 func TestUpdateApp(t *testing.T) {
 	projectKey := utils.GetTestProjectKey(t)
 	appKey := utils.GenerateUniqueKey("app-update")
@@ -104,3 +133,81 @@ func TestDeleteApp(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 404, statusCode)
 }
+
+func TestApplyApp(t *testing.T) {
+	projectKey := utils.GetTestProjectKey(t)
+	appKey := utils.GenerateUniqueKey("app-apply")
+	testPackage := utils.GetTestPackage(t)
+	manifest := fmt.Sprintf("application_key: %s\nproject_key: %s\nmaturity_level: production\nlabels:\n  env: prod\n"+
+		"packages:\n  - {type: %q, name: %q, version: %q}\n",
+		appKey, projectKey, testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion)
+	manifestPath := filepath.Join(t.TempDir(), "apps.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(manifest), 0644))
+
+	// Without --auto-approve, the plan is printed and the application isn't created.
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "app-apply", "--file="+manifestPath, "--format=json")
+	var changes []struct {
+		Action      string `json:"action"`
+		Application string `json:"application"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &changes))
+	require.Len(t, changes, 2)
+	assert.Equal(t, "create", changes[0].Action)
+	assert.Equal(t, "bind", changes[1].Action)
+	_, statusCode, err := utils.GetApplication(appKey)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode)
+
+	err = utils.AppTrustCli.Exec("app-apply", "--file="+manifestPath, "--auto-approve")
+	require.NoError(t, err)
+	defer utils.DeleteApplication(t, appKey)
+
+	app, _, err := utils.GetApplication(appKey)
+	require.NoError(t, err)
+	assert.Equal(t, projectKey, app.ProjectKey)
+	assert.Equal(t, "production", *app.MaturityLevel)
+	assert.Equal(t, map[string]string{"env": "prod"}, *app.Labels)
+	bindings, _, err := utils.GetPackageBindings(appKey)
+	require.NoError(t, err)
+	require.Len(t, bindings.Packages, 1)
+	assert.Equal(t, testPackage.PackageVersion, bindings.Packages[0].LatestVersion)
+
+	// The application matches the manifest.
+	output = utils.AppTrustCli.RunCliCmdWithOutput(t, "app-apply", "--file="+manifestPath, "--format=json")
+	assert.JSONEq(t, "[]", output)
+}
+
+func TestExportApp(t *testing.T) {
+	appKey := utils.GenerateUniqueKey("app-export")
+	err := utils.AppTrustCli.Exec("app-create", appKey,
+		"--project="+utils.GetTestProjectKey(t),
+		"--application-name=Exported Application",
+		"--maturity-level=production",
+		"--labels=env=prod;team=devops")
+	require.NoError(t, err)
+	defer utils.DeleteApplication(t, appKey)
+	testPackage := utils.GetTestPackage(t)
+	err = utils.AppTrustCli.Exec("package-bind", appKey, testPackage.PackageType, testPackage.PackageName, testPackage.PackageVersion)
+	require.NoError(t, err)
+
+	specPath := filepath.Join(t.TempDir(), "apps.yaml")
+	err = utils.AppTrustCli.Exec("app-export", appKey, "--include-packages", "--file="+specPath)
+	require.NoError(t, err)
+	content, err := os.ReadFile(specPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "application_key: "+appKey+"\n")
+	assert.Contains(t, string(content), "version: "+testPackage.PackageVersion+"\n")
+
+	// The exported spec creates a copy of the application.
+	copyKey := utils.GenerateUniqueKey("app-export-copy")
+	err = utils.AppTrustCli.Exec("app-create", copyKey, "--spec="+specPath)
+	require.NoError(t, err)
+	defer utils.DeleteApplication(t, copyKey)
+
+	original, _, err := utils.GetApplication(appKey)
+	require.NoError(t, err)
+	copied, _, err := utils.GetApplication(copyKey)
+	require.NoError(t, err)
+	copied.ApplicationKey = original.ApplicationKey
+	assert.Equal(t, original, copied)
+}
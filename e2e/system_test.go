@@ -3,13 +3,34 @@
 package e2e
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-application/e2e/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPing(t *testing.T) {
 	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "ping")
 	assert.Contains(t, output, "OK")
 }
+
+func TestDoctor(t *testing.T) {
+	projectKey := utils.GetTestProjectKey(t)
+	output := utils.AppTrustCli.RunCliCmdWithOutput(t, "doctor", "--project="+projectKey, "--format=json")
+
+	var report struct {
+		Passed bool `json:"passed"`
+		Checks []struct {
+			Name   string `json:"name"`
+			Status string `json:"status"`
+		} `json:"checks"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &report))
+	assert.True(t, report.Passed)
+	assert.NotEmpty(t, report.Checks)
+	for _, check := range report.Checks {
+		assert.NotEqual(t, "fail", check.Status, check.Name)
+	}
+}
@@ -45,6 +45,12 @@ var (
 func LoadCredentials() string {
 	platformUrlFlag := flag.String("jfrog.url", getTestPlatformUrlFromEnvVar(), "JFrog Platform URL")
 	accessTokenFlag := flag.String("jfrog.adminToken", os.Getenv(testJfrogTokenEnvVar), "JFrog Platform admin token")
+	fakeServerFlag := flag.Bool("apptrust.fakeServer", getFakeServerFromEnvVar(), "Run the tests against an in-memory fake AppTrust server")
+	flag.Parse()
+	if *fakeServerFlag {
+		*platformUrlFlag = startFakeServer()
+		*accessTokenFlag = fakeServerToken
+	}
 	platformUrl := clientUtils.AddTrailingSlashIfNeeded(*platformUrlFlag)
 
 	serverDetails = &coreConfig.ServerDetails{
@@ -74,6 +80,10 @@ func GetTestPackage(t *testing.T) *TestPackageResources {
 	if testPackageRes == nil {
 		buildName := GenerateUniqueKey("apptrust-cli-tests-build")
 		buildNumber := "1"
+		if isFakeServer() {
+			seedFakeTestPackage(t, buildName, buildNumber)
+			return testPackageRes
+		}
 		repoKey := createNpmRepo(t)
 		sha256 := uploadPackageToArtifactory(t, repoKey, buildName, buildNumber)
 		publishBuild(t, buildName, buildNumber, sha256)
@@ -82,6 +92,10 @@ func GetTestPackage(t *testing.T) *TestPackageResources {
 }
 
 func GetTestArtifact(t *testing.T) string {
+	if testArtifactPath == "" && isFakeServer() {
+		// The fake server accepts artifacts that are not in its catalog as generic artifacts.
+		testArtifactPath = GetTestProjectKey(t) + "-generic-local/test-artifact.txt"
+	}
 	if testArtifactPath == "" {
 		repoKey := createGenericRepo(t)
 		testArtifactPath = uploadSimpleFileToArtifactory(t, repoKey, "test-artifact.txt")
@@ -0,0 +1,86 @@
+//go:build e2e
+
+package utils
+
+import (
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-application/apptrust/fakeserver"
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFakeServerEnvVar = "JFROG_APPTRUST_CLI_TESTS_FAKE_SERVER"
+	fakeServerToken      = "fake-token"
+)
+
+var (
+	fakeServer     *fakeserver.Server
+	fakeHttpServer *httptest.Server
+)
+
+func getFakeServerFromEnvVar() bool {
+	useFakeServer, _ := strconv.ParseBool(os.Getenv(testFakeServerEnvVar))
+	return useFakeServer
+}
+
+// startFakeServer starts an in-memory AppTrust server, and returns its URL.
+func startFakeServer() string {
+	fakeServer = fakeserver.New(fakeserver.WithAccessToken(fakeServerToken))
+	fakeHttpServer = httptest.NewServer(fakeServer)
+	return fakeHttpServer.URL + "/"
+}
+
+func stopFakeServer() {
+	fakeHttpServer.Close()
+}
+
+func isFakeServer() bool {
+	return fakeServer != nil
+}
+
+// seedFakeTestPackage adds the test package and the build that produced it to the fake server,
+// in place of uploading them to Artifactory.
+func seedFakeTestPackage(t *testing.T, buildName, buildNumber string) {
+	repoKey := GetTestProjectKey(t) + "-npm-local"
+	testPackageRes = &TestPackageResources{
+		PackageType:    "npm",
+		PackageName:    "@gpizza/pizza-frontend",
+		PackageVersion: "1.0.0",
+		PackagePath:    repoKey + "/pizza-frontend.tgz",
+		RepoKey:        repoKey,
+		BuildName:      buildName,
+		BuildNumber:    buildNumber,
+	}
+	pkg := toFakePackage(testPackageRes)
+	err := fakeServer.Seed(fakeserver.SeedData{
+		Packages: []fakeserver.Package{pkg},
+		Builds:   []fakeserver.Build{{Name: buildName, Number: buildNumber, Packages: []fakeserver.Package{pkg}}},
+	})
+	require.NoError(t, err)
+}
+
+// seedFakeReleaseBundle adds a release bundle with the test package to the fake server.
+func seedFakeReleaseBundle(t *testing.T, projectKey, bundleName, bundleVersion string, testPackage *TestPackageResources) {
+	err := fakeServer.Seed(fakeserver.SeedData{ReleaseBundles: []fakeserver.ReleaseBundle{{
+		ProjectKey: projectKey,
+		Name:       bundleName,
+		Version:    bundleVersion,
+		Packages:   []fakeserver.Package{toFakePackage(testPackage)},
+	}}})
+	require.NoError(t, err)
+}
+
+func toFakePackage(testPackage *TestPackageResources) fakeserver.Package {
+	return fakeserver.Package{
+		Type:          testPackage.PackageType,
+		Name:          testPackage.PackageName,
+		Version:       testPackage.PackageVersion,
+		RepositoryKey: testPackage.RepoKey,
+		Artifacts:     []model.ReleasableArtifact{{Path: "pizza-frontend.tgz"}},
+	}
+}
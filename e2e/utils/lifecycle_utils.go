@@ -12,6 +12,11 @@ import (
 )
 
 func CreateReleaseBundle(t *testing.T, projectKey string, testPackage *TestPackageResources) (bundleName, bundleVersion string, cleanup func()) {
+	if isFakeServer() {
+		bundleName, bundleVersion = GenerateUniqueKey("apptrust-cli-tests-rb"), "1.0.0"
+		seedFakeReleaseBundle(t, projectKey, bundleName, bundleVersion, testPackage)
+		return bundleName, bundleVersion, func() {}
+	}
 	lcDetails, err := serverDetails.CreateLifecycleAuthConfig()
 	require.NoError(t, err)
 	serviceConfig, err := config.NewConfigBuilder().SetServiceDetails(lcDetails).Build()
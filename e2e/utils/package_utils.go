@@ -7,22 +7,12 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
-type PackagesResponse struct {
-	Packages []packageBinding `json:"packages"`
-}
-
-type packageBinding struct {
-	Type          string `json:"type"`
-	Name          string `json:"name"`
-	NumVersions   int    `json:"num_versions"`
-	LatestVersion string `json:"latest_version"`
-}
-
-func GetPackageBindings(appKey string) (*PackagesResponse, int, error) {
+func GetPackageBindings(appKey string) (*model.PackagesResponse, int, error) {
 	statusCode := 0
 	ctx, err := service.NewContext(*serverDetails)
 	if err != nil {
@@ -38,7 +28,7 @@ func GetPackageBindings(appKey string) (*PackagesResponse, int, error) {
 		return nil, statusCode, err
 	}
 
-	var packagesRes *PackagesResponse
+	var packagesRes *model.PackagesResponse
 	err = json.Unmarshal(responseBody, &packagesRes)
 	if err != nil {
 		return nil, statusCode, errorutils.CheckError(err)
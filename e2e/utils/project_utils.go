@@ -12,6 +12,11 @@ import (
 )
 
 func createTestProject(t *testing.T) {
+	if isFakeServer() {
+		// The fake server does not validate project keys.
+		testProjectKey = GenerateUniqueKey("apptrust-cli-tests")
+		return
+	}
 	accessManager, err := utils.CreateAccessServiceManager(serverDetails, false)
 	assert.NoError(t, err)
 	projectKey := GenerateUniqueKey("apptrust-cli-tests")
@@ -27,6 +32,10 @@ func createTestProject(t *testing.T) {
 }
 
 func DeleteTestProject() {
+	if isFakeServer() {
+		stopFakeServer()
+		return
+	}
 	if testProjectKey == "" {
 		return
 	}
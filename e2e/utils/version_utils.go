@@ -9,40 +9,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/apptrust/service"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-type VersionContentResponse struct {
-	ApplicationKey string       `json:"application_key"`
-	Version        string       `json:"version"`
-	Status         string       `json:"status"`
-	CurrentStage   string       `json:"current_stage,omitempty"`
-	Tag            string       `json:"tag,omitempty"`
-	Releasables    []releasable `json:"releasables"`
-}
-
-type releasable struct {
-	Name        string     `json:"name"`
-	Version     string     `json:"version"`
-	PackageType string     `json:"package_type"`
-	Artifacts   []artifact `json:"artifacts,omitempty"`
-}
-
-type artifact struct {
-	Path string `json:"path"`
-}
-
 const (
-	StatusDraft      = "DRAFT"
-	StatusInProgress = "IN_PROGRESS"
-	StatusStarted    = "STARTED"
-	StatusCompleted  = "COMPLETED"
+	StatusDraft      = model.VersionStatusDraft
+	StatusInProgress = model.VersionStatusInProgress
+	StatusStarted    = model.VersionStatusStarted
+	StatusCompleted  = model.VersionStatusCompleted
 )
 
-func GetApplicationVersion(appKey, version string) (*VersionContentResponse, int, error) {
+func GetApplicationVersion(appKey, version string) (*model.VersionContentResponse, int, error) {
 	statusCode := 0
 	ctx, err := service.NewContext(*serverDetails)
 	if err != nil {
@@ -58,7 +39,7 @@ func GetApplicationVersion(appKey, version string) (*VersionContentResponse, int
 		return nil, statusCode, err
 	}
 
-	var versionRes *VersionContentResponse
+	var versionRes *model.VersionContentResponse
 	err = json.Unmarshal(responseBody, &versionRes)
 	if err != nil {
 		return nil, statusCode, errorutils.CheckError(err)
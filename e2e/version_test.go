@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jfrog/jfrog-cli-application/apptrust/model"
 	"github.com/jfrog/jfrog-cli-application/e2e/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -222,7 +223,7 @@ func TestCreateVersion_Async(t *testing.T) {
 	assert.Contains(t, []string{utils.StatusInProgress, utils.StatusStarted}, response.Status)
 }
 
-func assertVersionContent(t *testing.T, expectedPackage *utils.TestPackageResources, versionContent *utils.VersionContentResponse, statusCode int, appKey, appVersion string) {
+func assertVersionContent(t *testing.T, expectedPackage *utils.TestPackageResources, versionContent *model.VersionContentResponse, statusCode int, appKey, appVersion string) {
 	assert.Equal(t, http.StatusOK, statusCode)
 	require.NotNil(t, versionContent)
 	assert.Equal(t, appKey, versionContent.ApplicationKey)